@@ -0,0 +1,236 @@
+package nft
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/osmosis-labs/osmosis/osmomath"
+	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
+)
+
+var _ OwnershipChecker = BankChecker{}
+
+// BankChecker checks the bank balances of the holder for a denom or a tokenfactory collection
+type BankChecker struct {
+	bankKeeper     bankkeeper.Keeper
+	bankStoreKey   sdk.StoreKey
+	tokenKeeper    tokenfactorykeeper.Keeper
+	holdingTracker *HoldingTracker
+}
+
+// NewBankChecker creates the checker for bank denoms, the NFTAuthenticator always registers it.
+// The bank store key is used to iterate only the balances of a holder within a collection
+func NewBankChecker(bankKeeper bankkeeper.Keeper, bankStoreKey sdk.StoreKey, tokenKeeper tokenfactorykeeper.Keeper) BankChecker {
+	return BankChecker{
+		bankKeeper:   bankKeeper,
+		bankStoreKey: bankStoreKey,
+		tokenKeeper:  tokenKeeper,
+	}
+}
+
+// WithHoldingTracker returns the checker with the tracker used to enforce the
+// min_holding_blocks of a config
+func (bc BankChecker) WithHoldingTracker(tracker HoldingTracker) BankChecker {
+	bc.holdingTracker = &tracker
+	return bc
+}
+
+func (bc BankChecker) Name() string {
+	return CheckerBank
+}
+
+// ValidateRequirement ensures the account controls the denom or collection it gates on
+func (bc BankChecker) ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error {
+	if err := bc.validateHoldingTracker(config); err != nil {
+		return err
+	}
+
+	// Denoms in a collection are created over time, but only the creator can create
+	// denoms under their address. The denoms that already exist must still be
+	// administered by the account, like a single denom
+	if requirement.Collection != nil {
+		creator, err := sdk.AccAddressFromBech32(requirement.Collection.Creator)
+		if err != nil || !creator.Equals(account) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not the creator of collection %s", account, requirement.Key())
+		}
+
+		denoms, err := bc.tokenKeeper.DenomsFromCreator(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomsFromCreatorRequest{Creator: requirement.Collection.Creator})
+		if err != nil {
+			return err
+		}
+		for _, denom := range denoms.Denoms {
+			if !strings.HasPrefix(denom, requirement.Collection.DenomPrefix()) {
+				continue
+			}
+			if err := bc.validateDenomAdmin(ctx, account, denom); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Only the admin of the denom can gate their account with it, otherwise a third
+	// party would decide who can act on behalf of the account
+	if err := bc.validateDenomAdmin(ctx, account, requirement.Denom); err != nil {
+		return err
+	}
+
+	// The NFT may not be minted yet, but if more than one exists already it can never be a NFT
+	if config.StrictSupply {
+		supply := bc.bankKeeper.GetSupply(ctx, requirement.Denom)
+		if supply.Amount.GT(osmomath.NewInt(1)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "strict supply enabled but denom %s has a supply of %s", requirement.Denom, supply.Amount)
+		}
+	}
+	return nil
+}
+
+// validateDenomAdmin ensures the denom is an existing tokenfactory denom and that
+// the account is the current tokenfactory admin of that denom
+func (bc BankChecker) validateDenomAdmin(ctx sdk.Context, account sdk.AccAddress, denom string) error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s is not a tokenfactory denom: %s", denom, err)
+	}
+
+	if _, found := bc.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "denom %s does not exist", denom)
+	}
+
+	authorityMetadata, err := bc.tokenKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	admin, err := sdk.AccAddressFromBech32(authorityMetadata.Admin)
+	if err != nil || !admin.Equals(account) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s is not the admin of denom %s", account, denom)
+	}
+
+	return nil
+}
+
+// validateHoldingTracker ensures the holding period of the config can be enforced
+func (bc BankChecker) validateHoldingTracker(config NFTAuthenticatorConfig) error {
+	if config.MinHoldingBlocks > 0 && bc.holdingTracker == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min holding blocks requires the bank checker to have a holding tracker")
+	}
+	return nil
+}
+
+// Owns checks the holders balance of the denom, or of any denom in the collection
+func (bc BankChecker) Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error) {
+	if requirement.Collection != nil {
+		return bc.ownsFromCollection(ctx, holder, config, *requirement.Collection)
+	}
+
+	// Get the balance of the denom for the holder
+	balance := bc.bankKeeper.GetBalance(ctx, holder, requirement.Denom)
+	return bc.isBalanceAccepted(ctx, holder, config, balance)
+}
+
+// ownsFromCollection looks for a denom of the collection the holder holds. Only the
+// balances of the holder under the denom prefix of the collection are iterated, so
+// the denoms anyone can send to the holder don't make the check more expensive and
+// only the creator can add denoms to the range
+func (bc BankChecker) ownsFromCollection(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, collection Collection) (bool, error) {
+	balances := prefix.NewStore(ctx.KVStore(bc.bankStoreKey), banktypes.CreateAccountBalancesPrefix(holder))
+	iterator := sdk.KVStorePrefixIterator(balances, []byte(collection.DenomPrefix()))
+	defer iterator.Close()
+
+	var refused error
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		if err := balance.Unmarshal(iterator.Value()); err != nil {
+			return false, err
+		}
+
+		// A refused denom doesn't stop the search, another denom of the collection may be accepted
+		accepted, err := bc.isCollectionBalanceAccepted(ctx, holder, config, collection, balance)
+		if accepted {
+			return true, nil
+		}
+		if err != nil && refused == nil {
+			refused = err
+		}
+	}
+	return false, refused
+}
+
+// isCollectionBalanceAccepted checks a balance of a collection denom, the creator may
+// have handed the admin of the denom to someone else who could then mint it freely
+func (bc BankChecker) isCollectionBalanceAccepted(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, collection Collection, balance sdk.Coin) (bool, error) {
+	authorityMetadata, err := bc.tokenKeeper.GetAuthorityMetadata(ctx, balance.Denom)
+	if err != nil {
+		return false, err
+	}
+	if authorityMetadata.Admin != collection.Creator {
+		return false, &RefusedError{
+			Reason:     ReasonDenomAdminChanged,
+			Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyDenom, balance.Denom)},
+		}
+	}
+	return bc.isBalanceAccepted(ctx, holder, config, balance)
+}
+
+// isBalanceAccepted checks that the holders balance is within the configured amount
+// and that it has been held for the configured number of blocks
+func (bc BankChecker) isBalanceAccepted(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, balance sdk.Coin) (bool, error) {
+	ctx.GasMeter().ConsumeGas(DenomCheckGas, "nft authenticator denom check")
+
+	if !config.IsAmountInBounds(balance.Amount) {
+		return false, nil
+	}
+
+	// In strict mode the denom is only a NFT if there is exactly one in existence,
+	// otherwise the admin could mint more and hand out access to several signers
+	if config.StrictSupply {
+		supply := bc.bankKeeper.GetSupply(ctx, balance.Denom)
+		if !supply.Amount.Equal(osmomath.NewInt(1)) {
+			return false, &RefusedError{
+				Reason: ReasonSupplyNotOne,
+				Attributes: []sdk.Attribute{
+					sdk.NewAttribute(AttributeKeyDenom, balance.Denom),
+					sdk.NewAttribute(AttributeKeySupply, supply.Amount.String()),
+				},
+			}
+		}
+	}
+
+	if config.MinHoldingBlocks > 0 {
+		return bc.isHeldLongEnough(ctx, holder, config, balance.Denom)
+	}
+	return true, nil
+}
+
+// isHeldLongEnough refuses a holder that acquired the denom less than min_holding_blocks
+// ago, so a key that just received the token can't act for the account yet. A balance
+// held since before the denom was gated counts from the gate height
+func (bc BankChecker) isHeldLongEnough(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, denom string) (bool, error) {
+	if err := bc.validateHoldingTracker(config); err != nil {
+		return false, err
+	}
+
+	heldSince, found := bc.holdingTracker.HeldSince(ctx, holder, denom)
+	if !found {
+		return false, &RefusedError{
+			Reason:     ReasonHoldingPeriodNotMet,
+			Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyDenom, denom)},
+		}
+	}
+	if ctx.BlockHeight()-heldSince < int64(config.MinHoldingBlocks) {
+		return false, &RefusedError{
+			Reason: ReasonHoldingPeriodNotMet,
+			Attributes: []sdk.Attribute{
+				sdk.NewAttribute(AttributeKeyDenom, denom),
+				sdk.NewAttribute(AttributeKeyHeldSince, strconv.FormatInt(heldSince, 10)),
+			},
+		}
+	}
+	return true, nil
+}
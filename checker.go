@@ -0,0 +1,61 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CheckerBank checks balances of bank denoms, it is always registered and is
+	// used for requirements that set a denom or a collection
+	CheckerBank = "bank"
+	// CheckerNFT checks ownership in the x/nft module, it is used for requirements
+	// that set a nft_class_id
+	CheckerNFT = "nft"
+	// CheckerCW721 queries CW721 contracts, it is used for requirements that set cw721
+	CheckerCW721 = "cw721"
+	// CheckerIBC checks bank balances of a denom and its IBC vouchers, it is used for
+	// requirements that set a denom with ibc_paths
+	CheckerIBC = "ibc"
+)
+
+// OwnershipChecker decides whether a holder owns the token named by a requirement.
+// Checkers are registered with the NFTAuthenticator and selected by the checker
+// field of a requirement, so a chain can plug in its own notion of ownership
+type OwnershipChecker interface {
+	// Name is the value of the checker field that selects the checker
+	Name() string
+
+	// ValidateRequirement is called when the authenticator is added to an account and
+	// should ensure the token exists and that the account is allowed to gate on it
+	ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error
+
+	// Owns returns whether the holder owns a token satisfying the requirement. Return
+	// a RefusedError to refuse a holder of the token for a specific reason, other errors
+	// refuse the signer with an ownership_check_failed reason
+	Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error)
+}
+
+// RefusedError is returned by an OwnershipChecker to refuse a holder for a reason
+// that is recorded with its attributes in the nft_authentication_refused event. The
+// holder is not authenticated, so the other authenticators of the account are tried
+type RefusedError struct {
+	Reason     string
+	Attributes []sdk.Attribute
+	// HoldingUnknown is set when the check failed, e.g. a query errored, so it isn't
+	// known whether the signer holds the token. The reason is recorded as soon as the
+	// check fails
+	HoldingUnknown bool
+}
+
+func (e *RefusedError) Error() string {
+	return "nft authentication refused: " + e.Reason
+}
+
+// isBuiltinChecker returns true for the checkers selected by the fields of a requirement
+func isBuiltinChecker(name string) bool {
+	switch name {
+	case CheckerBank, CheckerNFT, CheckerCW721, CheckerIBC:
+		return true
+	}
+	return false
+}
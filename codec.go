@@ -0,0 +1,49 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RouterKey is the route of the messages of the msg server, it is only used for
+// amino json signing since the messages are routed by their type url
+const RouterKey = "nftauthenticator"
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc is the amino codec used to build the sign bytes of the messages
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the messages of the msg server with the amino
+// codec, so they can be signed with amino json, e.g. by a ledger
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgResetUses{}, "nftauthenticator/MsgResetUses", nil)
+	cdc.RegisterConcrete(&MsgRevokeHolder{}, "nftauthenticator/MsgRevokeHolder", nil)
+	cdc.RegisterConcrete(&MsgUnrevokeHolder{}, "nftauthenticator/MsgUnrevokeHolder", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "nftauthenticator/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgResume{}, "nftauthenticator/MsgResume", nil)
+}
+
+// RegisterInterfaces registers the messages of the msg server
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResetUses{},
+		&MsgRevokeHolder{},
+		&MsgUnrevokeHolder{},
+		&MsgPause{},
+		&MsgResume{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,513 @@
+package nft
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/osmomath"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// Compile time type assertion for the config proto messages
+var _ proto.Message = &NFTAuthenticatorConfig{}
+var _ proto.Message = &Requirement{}
+var _ proto.Message = &CW721{}
+var _ proto.Message = &SpendLimit{}
+var _ proto.Message = &RateLimit{}
+
+const (
+	// ConfigVersion1 is the first version of the NFTAuthenticatorConfig format
+	ConfigVersion1 uint32 = 1
+)
+
+// reNFTID matches the class and NFT IDs accepted by the x/nft module
+var reNFTID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:-]{2,100}$`)
+
+// MatchMode defines how the requirements of a config are combined
+type MatchMode string
+
+const (
+	// MatchAnyOf authenticates a signer that satisfies any one of the requirements
+	MatchAnyOf MatchMode = "any_of"
+	// MatchAllOf authenticates a signer that satisfies every requirement
+	MatchAllOf MatchMode = "all_of"
+	// MatchWeighted authenticates a signer when the summed weights of the
+	// requirements they satisfy reach the threshold
+	MatchWeighted MatchMode = "weighted"
+)
+
+// SignersMode defines which signers of a tx must hold the requirements
+type SignersMode string
+
+const (
+	// SignersOne checks the signature for the authenticated account, other signers
+	// such as a fee payer are ignored
+	SignersOne SignersMode = "one"
+	// SignersAny authenticates when any signer of the tx is a holder
+	SignersAny SignersMode = "any"
+	// SignersAll authenticates when every signer of the tx is a holder
+	SignersAll SignersMode = "all"
+)
+
+// ParseConfig decodes the data an NFTAuthenticator is registered with, the data
+// can be a JSON object, a protobuf encoded NFTAuthenticatorConfig or, for
+// authenticators added before the config was versioned, a raw denom string
+// NOTE: a protobuf encoded config always starts with the version field, so it
+// can never be mistaken for a valid denom
+func ParseConfig(data []byte) (NFTAuthenticatorConfig, error) {
+	var config NFTAuthenticatorConfig
+
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case len(trimmed) == 0:
+		return config, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty nft authenticator config")
+	case trimmed[0] == '{':
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&config); err != nil {
+			return config, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "failed to unmarshal json config: "+err.Error())
+		}
+		// The config must be the only value in the data
+		if err := decoder.Decode(&json.RawMessage{}); err != io.EOF {
+			return config, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unexpected data after the json config")
+		}
+	case sdk.ValidateDenom(string(trimmed)) == nil:
+		// Legacy initialization data, the config is just the denom
+		config = NFTAuthenticatorConfig{
+			Version: ConfigVersion1,
+			Denom:   string(trimmed),
+		}
+	default:
+		if err := proto.Unmarshal(data, &config); err != nil {
+			return config, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "failed to unmarshal proto config: "+err.Error())
+		}
+	}
+
+	if err := config.Validate(); err != nil {
+		return NFTAuthenticatorConfig{}, err
+	}
+	return config, nil
+}
+
+// Validate checks that the config is a supported version and that all of the
+// fields are well formed, no state is accessed here
+func (c NFTAuthenticatorConfig) Validate() error {
+	if c.Version != ConfigVersion1 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported nft authenticator config version %d", c.Version)
+	}
+	if c.Denom != "" && len(c.Requirements) > 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "denom and requirements are mutually exclusive")
+	}
+
+	requirements := c.GetRequirements()
+	if len(requirements) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a denom or at least one requirement must be set")
+	}
+
+	seen := make(map[string]bool, len(requirements))
+	for _, requirement := range requirements {
+		if err := requirement.Validate(); err != nil {
+			return err
+		}
+		if seen[requirement.Key()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate requirement %s", requirement.Key())
+		}
+		seen[requirement.Key()] = true
+	}
+
+	switch c.GetMatch() {
+	case MatchAnyOf, MatchAllOf:
+		if c.Threshold != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "threshold is only used with the %s match mode", MatchWeighted)
+		}
+		for _, requirement := range requirements {
+			if requirement.Weight != 0 {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "weights are only used with the %s match mode", MatchWeighted)
+			}
+		}
+	case MatchWeighted:
+		if err := c.validateWeights(); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid match mode %q", c.Match)
+	}
+
+	allowed := make(map[string]bool, len(c.AllowedMessages))
+	for _, typeURL := range c.AllowedMessages {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid message type url %q", typeURL)
+		}
+		if allowed[typeURL] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed message %s", typeURL)
+		}
+		allowed[typeURL] = true
+	}
+
+	switch c.GetSigners() {
+	case SignersOne, SignersAny, SignersAll:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signers mode %q", c.Signers)
+	}
+
+	min, max, err := c.AmountBounds()
+	if err != nil {
+		return err
+	}
+	if !min.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "min amount must be positive")
+	}
+	if !max.IsNil() && max.LT(min) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max amount %s is less than min amount %s", max, min)
+	}
+
+	// With strict supply only one token exists, a signer can never hold more
+	if c.StrictSupply && !min.Equal(osmomath.OneInt()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "strict supply requires a min amount of 1, got %s", min)
+	}
+
+	// The supply is only known for bank denoms. The supply of an IBC voucher is only
+	// what was sent to this chain, so it says nothing about how many tokens exist
+	if c.StrictSupply {
+		for _, requirement := range requirements {
+			if len(requirement.IBCPaths) > 0 {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "strict supply can't be used with ibc paths")
+			}
+			if requirement.GetChecker() != CheckerBank || requirement.Denom == "" {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "strict supply only applies to bank denoms, not requirement %s", requirement.Key())
+			}
+		}
+	}
+
+	if c.SpendLimit != nil {
+		if err := c.SpendLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.RateLimit != nil {
+		if err := c.RateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Only the bank balances recorded by the HoldingTracker show how long a token was held
+	if c.MinHoldingBlocks > 0 {
+		for _, requirement := range requirements {
+			if checker := requirement.GetChecker(); checker != CheckerBank && checker != CheckerIBC {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min holding blocks can't be used with the %s checker of requirement %s", checker, requirement.Key())
+			}
+		}
+	}
+
+	notBefore, notAfter, err := c.ValidityWindow()
+	if err != nil {
+		return err
+	}
+	if !notBefore.IsZero() && !notAfter.IsZero() && !notAfter.After(notBefore) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "not after %s must be later than not before %s", c.NotAfter, c.NotBefore)
+	}
+	return nil
+}
+
+// Validate checks that the requirement names exactly one denom, collection, x/nft
+// class or CW721 contract, or that it only sets params for a custom checker
+func (r Requirement) Validate() error {
+	if r.Checker != "" && !isBuiltinChecker(r.Checker) {
+		// Custom checkers validate their params when the authenticator is added
+		if r.Denom != "" || r.Collection != nil || r.NFTClassID != "" || r.NFTID != "" || r.CW721 != nil || len(r.IBCPaths) > 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a requirement with the custom checker %s can only set params", r.Checker)
+		}
+		return nil
+	}
+	if r.Params != "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "params are only used with custom checkers")
+	}
+
+	set := 0
+	for _, isSet := range []bool{r.Denom != "", r.Collection != nil, r.NFTClassID != "", r.CW721 != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a requirement must set exactly one of denom, collection, nft_class_id or cw721")
+	}
+	if r.NFTID != "" && r.NFTClassID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nft_id requires nft_class_id")
+	}
+	if len(r.IBCPaths) > 0 && r.Denom == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "ibc_paths requires denom")
+	}
+
+	switch {
+	case r.Collection != nil:
+		if _, err := sdk.AccAddressFromBech32(r.Collection.Creator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid collection creator %q: %s", r.Collection.Creator, err)
+		}
+	case r.NFTClassID != "":
+		if !reNFTID.MatchString(r.NFTClassID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nft class id %q", r.NFTClassID)
+		}
+		if r.NFTID != "" && !reNFTID.MatchString(r.NFTID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid nft id %q", r.NFTID)
+		}
+	case r.CW721 != nil:
+		if _, err := sdk.AccAddressFromBech32(r.CW721.Contract); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid cw721 contract %q: %s", r.CW721.Contract, err)
+		}
+	default:
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom %q: %s", r.Denom, err)
+		}
+		seen := make(map[string]bool, len(r.IBCPaths))
+		for _, path := range r.IBCPaths {
+			trace := transfertypes.DenomTrace{Path: path, BaseDenom: r.Denom}
+			if path == "" || trace.Validate() != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ibc path %q", path)
+			}
+			if seen[path] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ibc path %s", path)
+			}
+			seen[path] = true
+		}
+	}
+
+	if r.Checker != "" && r.Checker != r.builtinChecker() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "requirement %s can't be checked by the %s checker", r.Key(), r.Checker)
+	}
+	return nil
+}
+
+// GetChecker returns the name of the ownership checker for the requirement, when
+// no checker is set it is selected by the fields the requirement sets
+func (r Requirement) GetChecker() string {
+	if r.Checker != "" {
+		return r.Checker
+	}
+	return r.builtinChecker()
+}
+
+// builtinChecker returns the built in checker for the fields the requirement sets
+func (r Requirement) builtinChecker() string {
+	switch {
+	case r.NFTClassID != "":
+		return CheckerNFT
+	case r.CW721 != nil:
+		return CheckerCW721
+	case len(r.IBCPaths) > 0:
+		return CheckerIBC
+	}
+	return CheckerBank
+}
+
+// Key uniquely identifies the requirement within a config
+func (r Requirement) Key() string {
+	switch {
+	case r.Collection != nil:
+		return r.Collection.DenomPrefix()
+	case r.NFTClassID != "" && r.NFTID != "":
+		return "nft:" + r.NFTClassID + "/" + r.NFTID
+	case r.NFTClassID != "":
+		return "nft:" + r.NFTClassID
+	case r.CW721 != nil && r.CW721.TokenID != "":
+		return "cw721:" + r.CW721.Contract + "/" + r.CW721.TokenID
+	case r.CW721 != nil:
+		return "cw721:" + r.CW721.Contract
+	case r.Checker != "" && !isBuiltinChecker(r.Checker):
+		return r.Checker + ":" + r.Params
+	}
+	return r.Denom
+}
+
+// DenomTraces returns the trace of the voucher for each allowed IBC path
+func (r Requirement) DenomTraces() []transfertypes.DenomTrace {
+	traces := make([]transfertypes.DenomTrace, 0, len(r.IBCPaths))
+	for _, path := range r.IBCPaths {
+		traces = append(traces, transfertypes.DenomTrace{Path: path, BaseDenom: r.Denom})
+	}
+	return traces
+}
+
+// DenomPrefix is the prefix shared by every denom in the collection
+func (c Collection) DenomPrefix() string {
+	return strings.Join([]string{tokenfactorytypes.ModuleDenomPrefix, c.Creator, c.SubdenomPrefix}, "/")
+}
+
+// validateWeights ensures every requirement has a weight and that the threshold
+// can be reached by holding all of the requirements
+func (c NFTAuthenticatorConfig) validateWeights() error {
+	if c.Threshold == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "threshold must be positive")
+	}
+
+	var total uint64
+	for _, requirement := range c.GetRequirements() {
+		if requirement.Weight == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "weight of requirement %s must be positive", requirement.Key())
+		}
+		if total+requirement.Weight < total {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "total weight overflows")
+		}
+		total += requirement.Weight
+	}
+
+	if total < c.Threshold {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "threshold %d is more than the total weight %d", c.Threshold, total)
+	}
+	return nil
+}
+
+// GetRequirements returns the requirements of the config, a config with a single
+// denom is the same as a config with one requirement for that denom
+func (c NFTAuthenticatorConfig) GetRequirements() []*Requirement {
+	if c.Denom != "" {
+		return []*Requirement{{Denom: c.Denom}}
+	}
+	return c.Requirements
+}
+
+// GetMatch returns how the requirements are combined, defaulting to any of
+func (c NFTAuthenticatorConfig) GetMatch() MatchMode {
+	if c.Match == "" {
+		return MatchAnyOf
+	}
+	return c.Match
+}
+
+// IsMessageAllowed checks the message type against the allowed messages, every
+// message is allowed when the list is empty. A MsgExec is allowed when every message
+// it executes is allowed
+func (c NFTAuthenticatorConfig) IsMessageAllowed(msg sdk.Msg) bool {
+	if len(c.AllowedMessages) == 0 {
+		return true
+	}
+	return !anyExecutedMessage(msg, func(msg sdk.Msg) bool {
+		typeURL := sdk.MsgTypeURL(msg)
+		for _, allowed := range c.AllowedMessages {
+			if allowed == typeURL {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// GetSigners returns which signers must hold the requirements, defaulting to the
+// signer for the account
+func (c NFTAuthenticatorConfig) GetSigners() SignersMode {
+	if c.Signers == "" {
+		return SignersOne
+	}
+	return c.Signers
+}
+
+// ValidityWindow returns the block times between which holders are authenticated,
+// both are inclusive and a zero time leaves that side of the window open
+func (c NFTAuthenticatorConfig) ValidityWindow() (notBefore time.Time, notAfter time.Time, err error) {
+	if c.NotBefore != "" {
+		if notBefore, err = time.Parse(time.RFC3339, c.NotBefore); err != nil {
+			return notBefore, notAfter, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid not before %q: %s", c.NotBefore, err)
+		}
+	}
+	if c.NotAfter != "" {
+		if notAfter, err = time.Parse(time.RFC3339, c.NotAfter); err != nil {
+			return notBefore, notAfter, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid not after %q: %s", c.NotAfter, err)
+		}
+	}
+	return notBefore, notAfter, nil
+}
+
+// AmountBounds returns the inclusive range the signers balance of the denom must be
+// within, a nil max means there is no upper bound. When neither bound is set the
+// signer must hold exactly one token, which is how a NFT is gated
+func (c NFTAuthenticatorConfig) AmountBounds() (min osmomath.Int, max osmomath.Int, err error) {
+	if c.MinAmount == "" && c.MaxAmount == "" {
+		return osmomath.OneInt(), osmomath.OneInt(), nil
+	}
+
+	min = osmomath.OneInt()
+	if c.MinAmount != "" {
+		var ok bool
+		if min, ok = osmomath.NewIntFromString(c.MinAmount); !ok {
+			return min, max, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min amount %q", c.MinAmount)
+		}
+	}
+
+	if c.MaxAmount != "" {
+		var ok bool
+		if max, ok = osmomath.NewIntFromString(c.MaxAmount); !ok {
+			return min, max, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max amount %q", c.MaxAmount)
+		}
+	}
+
+	return min, max, nil
+}
+
+// IsAmountInBounds checks the amount held by a signer against the configured bounds
+func (c NFTAuthenticatorConfig) IsAmountInBounds(amount osmomath.Int) bool {
+	min, max, err := c.AmountBounds()
+	if err != nil {
+		return false
+	}
+	return amount.GTE(min) && (max.IsNil() || amount.LTE(max))
+}
+
+// Validate checks that the limit is a list of positive coins and that the period
+// is a positive duration
+func (l SpendLimit) Validate() error {
+	limit, err := l.Coins()
+	if err != nil {
+		return err
+	}
+	if limit.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend limit must set at least one coin")
+	}
+	period, err := l.Duration()
+	if err != nil {
+		return err
+	}
+	if period <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "spend limit period must be positive, got %s", period)
+	}
+	return nil
+}
+
+// Coins parses the limit, e.g. "1000uosmo,5factory/osmo1.../token"
+func (l SpendLimit) Coins() (sdk.Coins, error) {
+	limit, err := sdk.ParseCoinsNormalized(l.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid spend limit %q: %s", l.Limit, err)
+	}
+	return limit, nil
+}
+
+// Duration parses the period, e.g. "24h"
+func (l SpendLimit) Duration() (time.Duration, error) {
+	period, err := time.ParseDuration(l.Period)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid spend limit period %q: %s", l.Period, err)
+	}
+	return period, nil
+}
+
+// Validate checks that the rate limit allows at least one message and that the
+// window is set in either blocks or seconds
+func (l RateLimit) Validate() error {
+	if l.Messages == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit messages must be positive")
+	}
+	if (l.Blocks == 0) == (l.Seconds == 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rate limit must set exactly one of blocks or seconds")
+	}
+	return nil
+}
@@ -0,0 +1,2429 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nftauthenticator/v1/config.proto
+
+package nft
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NFTAuthenticatorConfig is the initialization data stored alongside an
+// NFTAuthenticator when it is added to an account. It can be supplied either
+// protobuf encoded or as JSON, a raw denom string is also accepted for
+// authenticators registered before the config was versioned.
+type NFTAuthenticatorConfig struct {
+	// version of the config format, currently only 1 is supported
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// denom is the bank denom the signer must hold to authenticate
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// strict_supply requires the total supply of the denom to be exactly one,
+	// checked when the authenticator is added and on every authentication
+	StrictSupply bool `protobuf:"varint,3,opt,name=strict_supply,json=strictSupply,proto3" json:"strict_supply,omitempty"`
+	// min_amount and max_amount are the inclusive bounds of the signers balance of
+	// the denom, either can be omitted. When both are omitted the signer must hold
+	// exactly one token
+	MinAmount string `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount string `protobuf:"bytes,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// match defines how the requirements are combined, "any_of" authenticates a
+	// signer holding any one of them and is the default, "all_of" requires the
+	// signer to hold every one of them and "weighted" requires the summed weights
+	// of the requirements the signer holds to reach the threshold
+	Match MatchMode `protobuf:"bytes,6,opt,name=match,proto3,casttype=MatchMode" json:"match,omitempty"`
+	// requirements lists the tokens the signer can hold, it is mutually exclusive
+	// with denom which is the same as a single requirement
+	Requirements []*Requirement `protobuf:"bytes,7,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	// threshold is the summed weight needed with the "weighted" match mode
+	Threshold uint64 `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signers defines which signers of the tx must hold the requirements, "one"
+	// checks the signature for the account and is the default, "any" needs one
+	// signer of the tx to be a holder and "all" needs every signer to be a holder
+	Signers SignersMode `protobuf:"bytes,9,opt,name=signers,proto3,casttype=SignersMode" json:"signers,omitempty"`
+	// allowed_messages are the type urls of the messages holders can sign, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend", every message is allowed when empty
+	AllowedMessages []string `protobuf:"bytes,10,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// allow_admin_messages lets holders sign messages that could take over the
+	// account, i.e. authenticator management, authz grants and tokenfactory admin
+	// actions on the gating denoms, which are denied by default
+	AllowAdminMessages bool `protobuf:"varint,11,opt,name=allow_admin_messages,json=allowAdminMessages,proto3" json:"allow_admin_messages,omitempty"`
+	// spend_limit is how much each holder can spend from the account within a
+	// period, checked after the tx was executed
+	SpendLimit *SpendLimit `protobuf:"bytes,12,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// not_before and not_after are optional RFC3339 timestamps, e.g.
+	// "2024-12-31T23:59:59Z", holders are only authenticated while the block time
+	// is within them
+	NotBefore string `protobuf:"bytes,13,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  string `protobuf:"bytes,14,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// max_uses is the number of messages each holder can execute for the account,
+	// counted after the tx was executed. Once used up the holder is refused until
+	// the principal resets their uses with MsgResetUses
+	MaxUses uint64 `protobuf:"varint,15,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// rate_limit is how many messages each holder can execute within a sliding
+	// window, a holder that reached it is refused until executions leave it
+	RateLimit *RateLimit `protobuf:"bytes,16,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// min_holding_blocks is the number of blocks a holder must have held a gating
+	// bank denom without interruption, it requires a HoldingTracker on the chain
+	MinHoldingBlocks uint64 `protobuf:"varint,17,opt,name=min_holding_blocks,json=minHoldingBlocks,proto3" json:"min_holding_blocks,omitempty"`
+}
+
+func (m *NFTAuthenticatorConfig) Reset()         { *m = NFTAuthenticatorConfig{} }
+func (m *NFTAuthenticatorConfig) String() string { return proto.CompactTextString(m) }
+func (*NFTAuthenticatorConfig) ProtoMessage()    {}
+func (*NFTAuthenticatorConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{0}
+}
+func (m *NFTAuthenticatorConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTAuthenticatorConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTAuthenticatorConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTAuthenticatorConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTAuthenticatorConfig.Merge(m, src)
+}
+func (m *NFTAuthenticatorConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTAuthenticatorConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTAuthenticatorConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTAuthenticatorConfig proto.InternalMessageInfo
+
+// Requirement is a single token the signer can hold to satisfy the config,
+// exactly one of denom, collection, nft_class_id or cw721 must be set unless a
+// custom checker is used
+type Requirement struct {
+	// denom is the bank denom the signer must hold
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is added to the signers score when the requirement is held, only
+	// used with the "weighted" match mode
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// collection is satisfied by holding any denom of the collection
+	Collection *Collection `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	// nft_class_id is a class of the x/nft module, the requirement is satisfied
+	// by owning any NFT of the class
+	NFTClassID string `protobuf:"bytes,4,opt,name=nft_class_id,json=nftClassId,proto3" json:"nft_class_id,omitempty"`
+	// nft_id is optional, when set the signer must own this NFT of the class
+	NFTID string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// cw721 is satisfied by owning a token of the CW721 contract
+	CW721 *CW721 `protobuf:"bytes,6,opt,name=cw721,proto3" json:"cw721,omitempty"`
+	// checker selects the ownership checker registered with the authenticator,
+	// when empty "bank", "nft" or "cw721" is selected by the fields that are set
+	Checker string `protobuf:"bytes,7,opt,name=checker,proto3" json:"checker,omitempty"`
+	// params are passed to a custom checker, the built in checkers don't use them
+	Params string `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// ibc_paths are the IBC paths, e.g. "transfer/channel-0", over which vouchers
+	// of the denom are accepted as well as the denom itself
+	IBCPaths []string `protobuf:"bytes,9,rep,name=ibc_paths,json=ibcPaths,proto3" json:"ibc_paths,omitempty"`
+}
+
+func (m *Requirement) Reset()         { *m = Requirement{} }
+func (m *Requirement) String() string { return proto.CompactTextString(m) }
+func (*Requirement) ProtoMessage()    {}
+func (*Requirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{1}
+}
+func (m *Requirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Requirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Requirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Requirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Requirement.Merge(m, src)
+}
+func (m *Requirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Requirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Requirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Requirement proto.InternalMessageInfo
+
+// Collection is every tokenfactory denom created by the creator whose subdenom
+// starts with the subdenom prefix, i.e. factory/{creator}/{subdenom_prefix}...
+type Collection struct {
+	// creator is the address the denoms were created by, it must be the account
+	// the authenticator is added to
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// subdenom_prefix is optional, when empty every denom of the creator is part
+	// of the collection
+	SubdenomPrefix string `protobuf:"bytes,2,opt,name=subdenom_prefix,json=subdenomPrefix,proto3" json:"subdenom_prefix,omitempty"`
+}
+
+func (m *Collection) Reset()         { *m = Collection{} }
+func (m *Collection) String() string { return proto.CompactTextString(m) }
+func (*Collection) ProtoMessage()    {}
+func (*Collection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{2}
+}
+func (m *Collection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Collection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Collection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Collection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collection.Merge(m, src)
+}
+func (m *Collection) XXX_Size() int {
+	return m.Size()
+}
+func (m *Collection) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collection proto.InternalMessageInfo
+
+// CW721 is a token of a CW721 contract, ownership is checked with the owner_of
+// query when a token id is set and the tokens query otherwise
+type CW721 struct {
+	// contract is the address of the CW721 contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// token_id is optional, when empty owning any token of the contract is enough
+	TokenID string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *CW721) Reset()         { *m = CW721{} }
+func (m *CW721) String() string { return proto.CompactTextString(m) }
+func (*CW721) ProtoMessage()    {}
+func (*CW721) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{3}
+}
+func (m *CW721) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CW721) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CW721.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CW721) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CW721.Merge(m, src)
+}
+func (m *CW721) XXX_Size() int {
+	return m.Size()
+}
+func (m *CW721) XXX_DiscardUnknown() {
+	xxx_messageInfo_CW721.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CW721 proto.InternalMessageInfo
+
+// RateLimit is how many messages each holder can execute within a sliding window,
+// exactly one of blocks or seconds must be set
+type RateLimit struct {
+	// messages is the number of messages a holder can execute within the window
+	Messages uint64 `protobuf:"varint,1,opt,name=messages,proto3" json:"messages,omitempty"`
+	// blocks is the length of the window in blocks
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// seconds is the length of the window in seconds of block time
+	Seconds uint64 `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{4}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+// SpendLimit is how much each holder can spend from the account, the spend of a
+// tx is the decrease of the account balance of each limited denom
+type SpendLimit struct {
+	// limit is a list of coins, e.g. "1000uosmo,5uatom", denoms that aren't
+	// listed aren't limited
+	Limit string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// period is a duration, e.g. "24h", of the sliding window ending at the
+	// current block, a spend counts until the period elapsed since it
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *SpendLimit) Reset()         { *m = SpendLimit{} }
+func (m *SpendLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendLimit) ProtoMessage()    {}
+func (*SpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6918bca59061cc5, []int{5}
+}
+func (m *SpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimit.Merge(m, src)
+}
+func (m *SpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimit proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*NFTAuthenticatorConfig)(nil), "nftauthenticator.v1.NFTAuthenticatorConfig")
+	proto.RegisterType((*Requirement)(nil), "nftauthenticator.v1.Requirement")
+	proto.RegisterType((*Collection)(nil), "nftauthenticator.v1.Collection")
+	proto.RegisterType((*CW721)(nil), "nftauthenticator.v1.CW721")
+	proto.RegisterType((*RateLimit)(nil), "nftauthenticator.v1.RateLimit")
+	proto.RegisterType((*SpendLimit)(nil), "nftauthenticator.v1.SpendLimit")
+}
+
+func init() { proto.RegisterFile("nftauthenticator/v1/config.proto", fileDescriptor_e6918bca59061cc5) }
+
+var fileDescriptor_e6918bca59061cc5 = []byte{
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xb7, 0x4d, 0x13, 0xbf, 0xa4, 0x3f, 0x18, 0xaa, 0x95, 0x29, 0x90, 0x58, 0x59, 0x09,
+	0x52, 0x09, 0x92, 0x6d, 0x38, 0xac, 0xc4, 0x0a, 0x41, 0xdd, 0xaa, 0x22, 0x82, 0x96, 0x6a, 0x5a,
+	0x84, 0xe0, 0x62, 0x4d, 0xc6, 0xe3, 0x64, 0x54, 0x7b, 0x26, 0xcc, 0x4c, 0xda, 0xee, 0x7f, 0xc1,
+	0xdf, 0xc4, 0x69, 0x8f, 0x7b, 0xe4, 0x14, 0x41, 0x7a, 0xe3, 0x4f, 0xd8, 0x13, 0x9a, 0xb1, 0x93,
+	0x74, 0x51, 0xb5, 0xb7, 0xf7, 0x7d, 0xdf, 0x7b, 0xe3, 0xe7, 0x6f, 0x3e, 0x1b, 0x42, 0x91, 0x1a,
+	0x32, 0x35, 0x63, 0x26, 0x0c, 0xa7, 0xc4, 0x48, 0xd5, 0xbb, 0x39, 0xec, 0x51, 0x29, 0x52, 0x3e,
+	0xea, 0x4e, 0x94, 0x34, 0x12, 0x7d, 0xf8, 0xff, 0x8e, 0xee, 0xcd, 0xe1, 0xfe, 0xde, 0x48, 0x8e,
+	0xa4, 0xd3, 0x7b, 0xb6, 0x2a, 0x5a, 0xdb, 0x7f, 0x56, 0xe0, 0xe9, 0xf9, 0xe9, 0xd5, 0xd1, 0xc3,
+	0xee, 0x63, 0x77, 0x16, 0x0a, 0xa0, 0x7a, 0xc3, 0x94, 0xe6, 0x52, 0x04, 0x5e, 0xe8, 0x75, 0xb6,
+	0xf0, 0x02, 0xa2, 0x3d, 0xa8, 0x24, 0x4c, 0xc8, 0x3c, 0x78, 0x12, 0x7a, 0x1d, 0x1f, 0x17, 0x00,
+	0x3d, 0x83, 0x2d, 0x6d, 0x14, 0xa7, 0x26, 0xd6, 0xd3, 0xc9, 0x24, 0x7b, 0x15, 0xac, 0x87, 0x5e,
+	0xa7, 0x86, 0x1b, 0x05, 0x79, 0xe9, 0x38, 0xf4, 0x29, 0x40, 0xce, 0x45, 0x4c, 0x72, 0x39, 0x15,
+	0x26, 0xd8, 0x70, 0xf3, 0x7e, 0xce, 0xc5, 0x91, 0x23, 0x9c, 0x4c, 0xee, 0x16, 0x72, 0xa5, 0x94,
+	0xc9, 0x5d, 0x29, 0x3f, 0x83, 0x4a, 0x4e, 0x0c, 0x1d, 0x07, 0x9b, 0x56, 0x89, 0xb6, 0xde, 0xce,
+	0x5a, 0xfe, 0x99, 0x25, 0xce, 0x64, 0xc2, 0x70, 0xa1, 0xa1, 0x13, 0x68, 0x28, 0xf6, 0xfb, 0x94,
+	0x2b, 0x96, 0x33, 0x61, 0x74, 0x50, 0x0d, 0xd7, 0x3b, 0xf5, 0x7e, 0xd8, 0x7d, 0xc4, 0x94, 0x2e,
+	0x5e, 0x35, 0xe2, 0x77, 0xa6, 0xd0, 0x27, 0xe0, 0x9b, 0xb1, 0x62, 0x7a, 0x2c, 0xb3, 0x24, 0xa8,
+	0x85, 0x5e, 0x67, 0x03, 0xaf, 0x08, 0x74, 0x00, 0x55, 0xcd, 0x47, 0x82, 0x29, 0x1d, 0xf8, 0x6e,
+	0x95, 0x9d, 0xb7, 0xb3, 0x56, 0xfd, 0xb2, 0xa0, 0xdc, 0x32, 0x0b, 0x1d, 0x1d, 0xc0, 0x2e, 0xc9,
+	0x32, 0x79, 0xcb, 0x92, 0x38, 0x67, 0x5a, 0x93, 0x11, 0xd3, 0x01, 0x84, 0xeb, 0x1d, 0x1f, 0xef,
+	0x94, 0xfc, 0x59, 0x49, 0xa3, 0xe7, 0xb0, 0xe7, 0xa8, 0x98, 0x24, 0xd6, 0xa4, 0x65, 0x7b, 0xdd,
+	0x19, 0x89, 0x9c, 0x76, 0x64, 0xa5, 0xe5, 0xc4, 0x77, 0x50, 0xd7, 0x13, 0x26, 0x92, 0x38, 0xe3,
+	0x39, 0x37, 0x41, 0x23, 0xf4, 0x3a, 0xf5, 0x7e, 0xeb, 0xd1, 0x57, 0xbd, 0xb4, 0x7d, 0x3f, 0xda,
+	0x36, 0x0c, 0x7a, 0x59, 0x5b, 0xc7, 0x85, 0x34, 0xf1, 0x90, 0xa5, 0x52, 0xb1, 0x60, 0xab, 0x70,
+	0x5c, 0x48, 0x13, 0x39, 0x02, 0x7d, 0x0c, 0x16, 0xc4, 0x24, 0x35, 0x4c, 0x05, 0xdb, 0x4e, 0xad,
+	0x09, 0x69, 0x8e, 0x2c, 0x46, 0x1f, 0x41, 0xcd, 0xde, 0xd6, 0x54, 0x33, 0x1d, 0xec, 0x38, 0x8b,
+	0xaa, 0x39, 0xb9, 0xfb, 0x59, 0x33, 0x8d, 0xbe, 0x01, 0x50, 0xc4, 0xb0, 0x72, 0xaf, 0x5d, 0xb7,
+	0x57, 0xf3, 0xf1, 0x2b, 0x20, 0x86, 0x15, 0x6b, 0xf9, 0x6a, 0x51, 0xa2, 0x2f, 0x00, 0x59, 0x07,
+	0xac, 0xd7, 0x5c, 0x8c, 0xe2, 0x61, 0x26, 0xe9, 0xb5, 0x0e, 0x3e, 0x70, 0xcf, 0xd8, 0xcd, 0xb9,
+	0xf8, 0xbe, 0x10, 0x22, 0xc7, 0xb7, 0xff, 0x7d, 0x02, 0xf5, 0x07, 0x37, 0xb9, 0xca, 0xa7, 0xf7,
+	0x30, 0x9f, 0x4f, 0x61, 0xf3, 0x96, 0xf1, 0xd1, 0xd8, 0xb8, 0xd8, 0x6e, 0xe0, 0x12, 0xa1, 0x6f,
+	0x01, 0xa8, 0xcc, 0x32, 0x46, 0x8d, 0x8d, 0xfa, 0xfa, 0x7b, 0x2c, 0x3c, 0x5e, 0xb6, 0xe1, 0x07,
+	0x23, 0xe8, 0x39, 0x34, 0x44, 0x6a, 0x62, 0x9a, 0x11, 0xad, 0x63, 0x9e, 0x14, 0xa9, 0x8e, 0xb6,
+	0xe7, 0xb3, 0x16, 0x9c, 0x9f, 0x5e, 0x1d, 0x5b, 0x7a, 0x70, 0x82, 0x41, 0xa4, 0xa6, 0xa8, 0x13,
+	0x14, 0xc2, 0xa6, 0x9d, 0xe0, 0x49, 0x11, 0xf1, 0xc8, 0x9f, 0xcf, 0x5a, 0x95, 0xf3, 0xd3, 0xab,
+	0xc1, 0x09, 0xae, 0x88, 0xd4, 0x0c, 0x12, 0xf4, 0x12, 0x2a, 0xf4, 0xf6, 0x45, 0xff, 0xd0, 0x25,
+	0xbd, 0xde, 0xdf, 0x7f, 0x7c, 0x9f, 0x5f, 0x5e, 0xf4, 0x0f, 0x8b, 0x61, 0x57, 0xe2, 0x62, 0xc6,
+	0x7e, 0xb9, 0x74, 0xcc, 0xe8, 0x35, 0x53, 0x41, 0xd5, 0x39, 0xb0, 0x80, 0xd6, 0x83, 0x09, 0x51,
+	0x24, 0xd7, 0x2e, 0xd2, 0x3e, 0x2e, 0x11, 0x3a, 0x00, 0x9f, 0x0f, 0x69, 0x3c, 0x21, 0x66, 0x6c,
+	0x13, 0xbd, 0xde, 0xf1, 0xa3, 0xc6, 0x7c, 0xd6, 0xaa, 0x0d, 0xa2, 0xe3, 0x0b, 0xcb, 0xe1, 0x1a,
+	0x1f, 0x52, 0x57, 0xb5, 0x7f, 0x02, 0x58, 0xf9, 0xe0, 0x1e, 0xa5, 0x98, 0x5d, 0xa8, 0x34, 0x7b,
+	0x01, 0xd1, 0xe7, 0xb0, 0xa3, 0xa7, 0x43, 0x67, 0x7d, 0x3c, 0x51, 0x2c, 0xe5, 0x77, 0xe5, 0xef,
+	0x62, 0x7b, 0x41, 0x5f, 0x38, 0xb6, 0xfd, 0x03, 0x14, 0xdb, 0xa3, 0x7d, 0xa8, 0x51, 0x29, 0x8c,
+	0x22, 0xd4, 0x94, 0x87, 0x2d, 0x31, 0xfa, 0x0c, 0x6a, 0x46, 0x5e, 0x33, 0x61, 0x3d, 0x73, 0xc7,
+	0x44, 0xf5, 0xf9, 0xac, 0x55, 0xbd, 0xb2, 0xdc, 0xe0, 0x04, 0x57, 0x9d, 0x38, 0x48, 0xda, 0xbf,
+	0x82, 0xbf, 0x0c, 0x94, 0x3d, 0x70, 0xf9, 0x0d, 0x79, 0xee, 0xce, 0x97, 0xd8, 0x3a, 0x51, 0xa6,
+	0xaa, 0x4c, 0x43, 0x81, 0xec, 0x0b, 0x69, 0x46, 0xa5, 0x48, 0xb4, 0x8b, 0xc2, 0x06, 0x5e, 0xc0,
+	0xf6, 0xd7, 0x00, 0xab, 0x6f, 0xc8, 0x66, 0xac, 0xc8, 0x76, 0x99, 0x31, 0x07, 0x9c, 0xbf, 0x4c,
+	0x71, 0x59, 0x2e, 0x89, 0x4b, 0x14, 0x9d, 0xbe, 0xfe, 0xa7, 0xb9, 0xf6, 0x7a, 0xde, 0xf4, 0xde,
+	0xcc, 0x9b, 0xde, 0xdf, 0xf3, 0xa6, 0xf7, 0xc7, 0x7d, 0x73, 0xed, 0xcd, 0x7d, 0x73, 0xed, 0xaf,
+	0xfb, 0xe6, 0xda, 0x6f, 0x9d, 0x11, 0x37, 0xe3, 0xe9, 0xb0, 0x4b, 0x65, 0xde, 0xbb, 0x20, 0x49,
+	0xf2, 0xea, 0x8c, 0xf6, 0x44, 0x6a, 0xbe, 0x7c, 0xe7, 0xc2, 0x5f, 0x8a, 0xd4, 0x0c, 0x37, 0xdd,
+	0x5f, 0xfb, 0xab, 0xff, 0x06, 0x00, 0x13, 0xa3, 0x2d, 0xe1, 0x04, 0x06, 0x00, 0x00,
+}
+
+func (m *NFTAuthenticatorConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTAuthenticatorConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTAuthenticatorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinHoldingBlocks != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MinHoldingBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.NotAfter) > 0 {
+		i -= len(m.NotAfter)
+		copy(dAtA[i:], m.NotAfter)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.NotAfter)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.NotBefore) > 0 {
+		i -= len(m.NotBefore)
+		copy(dAtA[i:], m.NotBefore)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.NotBefore)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AllowAdminMessages {
+		i--
+		if m.AllowAdminMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Signers) > 0 {
+		i -= len(m.Signers)
+		copy(dAtA[i:], m.Signers)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Signers)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Requirements) > 0 {
+		for iNdEx := len(m.Requirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Match) > 0 {
+		i -= len(m.Match)
+		copy(dAtA[i:], m.Match)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Match)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StrictSupply {
+		i--
+		if m.StrictSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Requirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Requirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Requirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IBCPaths) > 0 {
+		for iNdEx := len(m.IBCPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCPaths[iNdEx])
+			copy(dAtA[i:], m.IBCPaths[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.IBCPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CW721 != nil {
+		{
+			size, err := m.CW721.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NFTID) > 0 {
+		i -= len(m.NFTID)
+		copy(dAtA[i:], m.NFTID)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.NFTID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NFTClassID) > 0 {
+		i -= len(m.NFTClassID)
+		copy(dAtA[i:], m.NFTClassID)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.NFTClassID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Collection != nil {
+		{
+			size, err := m.Collection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Weight != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Collection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Collection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Collection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubdenomPrefix) > 0 {
+		i -= len(m.SubdenomPrefix)
+		copy(dAtA[i:], m.SubdenomPrefix)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.SubdenomPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CW721) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CW721) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CW721) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenID) > 0 {
+		i -= len(m.TokenID)
+		copy(dAtA[i:], m.TokenID)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.TokenID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Messages != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Messages))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Limit) > 0 {
+		i -= len(m.Limit)
+		copy(dAtA[i:], m.Limit)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Limit)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConfig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConfig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NFTAuthenticatorConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovConfig(uint64(m.Version))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.StrictSupply {
+		n += 2
+	}
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Match)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if len(m.Requirements) > 0 {
+		for _, e := range m.Requirements {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovConfig(uint64(m.Threshold))
+	}
+	l = len(m.Signers)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	if m.AllowAdminMessages {
+		n += 2
+	}
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.NotBefore)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.NotAfter)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovConfig(uint64(m.MaxUses))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.MinHoldingBlocks != 0 {
+		n += 2 + sovConfig(uint64(m.MinHoldingBlocks))
+	}
+	return n
+}
+
+func (m *Requirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovConfig(uint64(m.Weight))
+	}
+	if m.Collection != nil {
+		l = m.Collection.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.NFTClassID)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.NFTID)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.CW721 != nil {
+		l = m.CW721.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if len(m.IBCPaths) > 0 {
+		for _, s := range m.IBCPaths {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Collection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.SubdenomPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *CW721) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.TokenID)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Messages != 0 {
+		n += 1 + sovConfig(uint64(m.Messages))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovConfig(uint64(m.Blocks))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovConfig(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *SpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func sovConfig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConfig(x uint64) (n int) {
+	return sovConfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NFTAuthenticatorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTAuthenticatorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTAuthenticatorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSupply = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = MatchMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requirements = append(m.Requirements, &Requirement{})
+			if err := m.Requirements[len(m.Requirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = SignersMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAdminMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAdminMessages = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &SpendLimit{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotBefore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotAfter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHoldingBlocks", wireType)
+			}
+			m.MinHoldingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHoldingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Requirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Requirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Requirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Collection == nil {
+				m.Collection = &Collection{}
+			}
+			if err := m.Collection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CW721", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CW721 == nil {
+				m.CW721 = &CW721{}
+			}
+			if err := m.CW721.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPaths = append(m.IBCPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Collection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubdenomPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubdenomPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CW721) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CW721: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CW721: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			m.Messages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Messages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConfig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConfig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConfig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConfig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConfig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConfig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConfig = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,442 @@
+package nft
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseConfig checks every accepted format for the initialization data
+// of the NFTAuthenticator as well as the malformed configs that must be rejected
+func TestParseConfig(t *testing.T) {
+	denom := "factory/osmo1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/nft"
+	creator := sdk.AccAddress([]byte("collection_creator__")).String()
+
+	protoConfig, err := proto.Marshal(&NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom})
+	require.NoError(t, err)
+
+	unsupportedVersion, err := proto.Marshal(&NFTAuthenticatorConfig{Version: 2, Denom: denom})
+	require.NoError(t, err)
+
+	anyOfConfig := NFTAuthenticatorConfig{
+		Version:      ConfigVersion1,
+		Match:        MatchAnyOf,
+		Requirements: []*Requirement{{Denom: denom + "/ops"}, {Denom: denom + "/oncall"}},
+	}
+	protoAnyOfConfig, err := proto.Marshal(&anyOfConfig)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected NFTAuthenticatorConfig
+		err      string
+	}{
+		{
+			name:     "legacy raw denom",
+			data:     []byte(denom),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom},
+		},
+		{
+			name:     "json config",
+			data:     []byte(`{"version":1,"denom":"` + denom + `"}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom},
+		},
+		{
+			name:     "legacy raw denom with whitespace",
+			data:     []byte(" " + denom + "\n"),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom},
+		},
+		{
+			name: "json trailing data",
+			data: []byte(`{"version":1,"denom":"` + denom + `"}garbage`),
+			err:  "unexpected data after the json config",
+		},
+		{
+			name: "json second object",
+			data: []byte(`{"version":1,"denom":"` + denom + `"} {"version":1}`),
+			err:  "unexpected data after the json config",
+		},
+		{
+			name:     "proto config",
+			data:     protoConfig,
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom},
+		},
+		{
+			name: "empty data",
+			data: []byte{},
+			err:  "empty nft authenticator config",
+		},
+		{
+			name: "garbage bytes",
+			data: []byte{0xff, 0x00, 0x13},
+			err:  "failed to unmarshal proto config",
+		},
+		{
+			name: "json unknown field",
+			data: []byte(`{"version":1,"denom":"` + denom + `","owner":"alice"}`),
+			err:  "failed to unmarshal json config",
+		},
+		{
+			name: "json missing version",
+			data: []byte(`{"denom":"` + denom + `"}`),
+			err:  "unsupported nft authenticator config version 0",
+		},
+		{
+			name: "unsupported proto version",
+			data: unsupportedVersion,
+			err:  "unsupported nft authenticator config version 2",
+		},
+		{
+			name: "invalid denom",
+			data: []byte(`{"version":1,"denom":"x"}`),
+			err:  "invalid denom",
+		},
+		{
+			name:     "amount range",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","min_amount":"1000","max_amount":"5000"}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, MinAmount: "1000", MaxAmount: "5000"},
+		},
+		{
+			name: "invalid min amount",
+			data: []byte(`{"version":1,"denom":"` + denom + `","min_amount":"lots"}`),
+			err:  "invalid min amount",
+		},
+		{
+			name: "zero min amount",
+			data: []byte(`{"version":1,"denom":"` + denom + `","min_amount":"0"}`),
+			err:  "min amount must be positive",
+		},
+		{
+			name: "max less than min",
+			data: []byte(`{"version":1,"denom":"` + denom + `","min_amount":"10","max_amount":"5"}`),
+			err:  "max amount 5 is less than min amount 10",
+		},
+		{
+			name:     "json requirements",
+			data:     []byte(`{"version":1,"match":"any_of","requirements":[{"denom":"` + denom + `/ops"},{"denom":"` + denom + `/oncall"}]}`),
+			expected: anyOfConfig,
+		},
+		{
+			name:     "proto requirements",
+			data:     protoAnyOfConfig,
+			expected: anyOfConfig,
+		},
+		{
+			name: "denom and requirements",
+			data: []byte(`{"version":1,"denom":"` + denom + `","requirements":[{"denom":"` + denom + `/ops"}]}`),
+			err:  "denom and requirements are mutually exclusive",
+		},
+		{
+			name: "no denom or requirements",
+			data: []byte(`{"version":1}`),
+			err:  "a denom or at least one requirement must be set",
+		},
+		{
+			name: "duplicate requirement",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `"},{"denom":"` + denom + `"}]}`),
+			err:  "duplicate requirement",
+		},
+		{
+			name: "invalid match mode",
+			data: []byte(`{"version":1,"match":"some_of","denom":"` + denom + `"}`),
+			err:  "invalid match mode",
+		},
+		{
+			name: "weighted",
+			data: []byte(`{"version":1,"match":"weighted","threshold":2,"requirements":[{"denom":"` + denom + `/gold","weight":2},{"denom":"` + denom + `/silver","weight":1}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version:      ConfigVersion1,
+				Match:        MatchWeighted,
+				Threshold:    2,
+				Requirements: []*Requirement{{Denom: denom + "/gold", Weight: 2}, {Denom: denom + "/silver", Weight: 1}},
+			},
+		},
+		{
+			name: "weighted threshold not reachable",
+			data: []byte(`{"version":1,"match":"weighted","threshold":4,"requirements":[{"denom":"` + denom + `/gold","weight":2},{"denom":"` + denom + `/silver","weight":1}]}`),
+			err:  "threshold 4 is more than the total weight 3",
+		},
+		{
+			name: "weighted without threshold",
+			data: []byte(`{"version":1,"match":"weighted","requirements":[{"denom":"` + denom + `/gold","weight":2}]}`),
+			err:  "threshold must be positive",
+		},
+		{
+			name: "weighted without weight",
+			data: []byte(`{"version":1,"match":"weighted","threshold":1,"requirements":[{"denom":"` + denom + `/gold"}]}`),
+			err:  "must be positive",
+		},
+		{
+			name: "weight without weighted match",
+			data: []byte(`{"version":1,"match":"any_of","requirements":[{"denom":"` + denom + `/gold","weight":2}]}`),
+			err:  "weights are only used with the weighted match mode",
+		},
+		{
+			name: "threshold without weighted match",
+			data: []byte(`{"version":1,"match":"all_of","threshold":2,"requirements":[{"denom":"` + denom + `/gold"}]}`),
+			err:  "threshold is only used with the weighted match mode",
+		},
+		{
+			name: "collection",
+			data: []byte(`{"version":1,"requirements":[{"collection":{"creator":"` + creator + `","subdenom_prefix":"member-"}}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version: ConfigVersion1,
+				Requirements: []*Requirement{{Collection: &Collection{
+					Creator:        creator,
+					SubdenomPrefix: "member-",
+				}}},
+			},
+		},
+		{
+			name: "collection with invalid creator",
+			data: []byte(`{"version":1,"requirements":[{"collection":{"creator":"alice"}}]}`),
+			err:  "invalid collection creator",
+		},
+		{
+			name: "requirement with denom and collection",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","collection":{"creator":"` + creator + `"}}]}`),
+			err:  "a requirement must set exactly one of denom, collection, nft_class_id or cw721",
+		},
+		{
+			name: "nft class",
+			data: []byte(`{"version":1,"requirements":[{"nft_class_id":"members"},{"nft_class_id":"members","nft_id":"alice"}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version:      ConfigVersion1,
+				Requirements: []*Requirement{{NFTClassID: "members"}, {NFTClassID: "members", NFTID: "alice"}},
+			},
+		},
+		{
+			name: "nft id without class",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","nft_id":"alice"}]}`),
+			err:  "nft_id requires nft_class_id",
+		},
+		{
+			name: "invalid nft class id",
+			data: []byte(`{"version":1,"requirements":[{"nft_class_id":"1x"}]}`),
+			err:  "invalid nft class id",
+		},
+		{
+			name: "requirement with denom and nft class",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","nft_class_id":"members"}]}`),
+			err:  "a requirement must set exactly one of denom, collection, nft_class_id or cw721",
+		},
+		{
+			name: "cw721",
+			data: []byte(`{"version":1,"requirements":[{"cw721":{"contract":"` + creator + `","token_id":"1"}}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version:      ConfigVersion1,
+				Requirements: []*Requirement{{CW721: &CW721{Contract: creator, TokenID: "1"}}},
+			},
+		},
+		{
+			name: "cw721 with invalid contract",
+			data: []byte(`{"version":1,"requirements":[{"cw721":{"contract":"alice"}}]}`),
+			err:  "invalid cw721 contract",
+		},
+		{
+			name: "custom checker",
+			data: []byte(`{"version":1,"requirements":[{"checker":"guild","params":"{\"rank\":3}"}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version:      ConfigVersion1,
+				Requirements: []*Requirement{{Checker: "guild", Params: `{"rank":3}`}},
+			},
+		},
+		{
+			name: "custom checker with denom",
+			data: []byte(`{"version":1,"requirements":[{"checker":"guild","denom":"` + denom + `"}]}`),
+			err:  "a requirement with the custom checker guild can only set params",
+		},
+		{
+			name: "params without custom checker",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","params":"x"}]}`),
+			err:  "params are only used with custom checkers",
+		},
+		{
+			name: "built in checker not matching requirement",
+			data: []byte(`{"version":1,"requirements":[{"checker":"bank","nft_class_id":"members"}]}`),
+			err:  "can't be checked by the bank checker",
+		},
+		{
+			name: "ibc paths",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","ibc_paths":["transfer/channel-0"]}]}`),
+			expected: NFTAuthenticatorConfig{
+				Version:      ConfigVersion1,
+				Requirements: []*Requirement{{Denom: denom, IBCPaths: []string{"transfer/channel-0"}}},
+			},
+		},
+		{
+			name: "invalid ibc path",
+			data: []byte(`{"version":1,"requirements":[{"denom":"` + denom + `","ibc_paths":["transfer"]}]}`),
+			err:  "invalid ibc path",
+		},
+		{
+			name: "ibc paths without denom",
+			data: []byte(`{"version":1,"requirements":[{"nft_class_id":"members","ibc_paths":["transfer/channel-0"]}]}`),
+			err:  "ibc_paths requires denom",
+		},
+		{
+			name: "ibc paths with strict supply",
+			data: []byte(`{"version":1,"strict_supply":true,"requirements":[{"denom":"` + denom + `","ibc_paths":["transfer/channel-0"]}]}`),
+			err:  "strict supply can't be used with ibc paths",
+		},
+		{
+			name: "collection with strict supply",
+			data: []byte(`{"version":1,"strict_supply":true,"requirements":[{"collection":{"creator":"` + creator + `"}}]}`),
+			err:  "strict supply only applies to bank denoms",
+		},
+		{
+			name: "nft class with strict supply",
+			data: []byte(`{"version":1,"strict_supply":true,"requirements":[{"nft_class_id":"members"}]}`),
+			err:  "strict supply only applies to bank denoms",
+		},
+		{
+			name: "cw721 with strict supply",
+			data: []byte(`{"version":1,"strict_supply":true,"requirements":[{"cw721":{"contract":"` + creator + `"}}]}`),
+			err:  "strict supply only applies to bank denoms",
+		},
+		{
+			name: "custom checker with strict supply",
+			data: []byte(`{"version":1,"strict_supply":true,"requirements":[{"checker":"guild","params":"{\"rank\":3}"}]}`),
+			err:  "strict supply only applies to bank denoms",
+		},
+		{
+			name:     "signers mode",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","signers":"all"}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, Signers: SignersAll},
+		},
+		{
+			name: "invalid signers mode",
+			data: []byte(`{"version":1,"denom":"` + denom + `","signers":"most"}`),
+			err:  "invalid signers mode",
+		},
+		{
+			name:     "allowed messages",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["/cosmos.bank.v1beta1.MsgSend"]}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, AllowedMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		{
+			name: "invalid allowed message",
+			data: []byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["cosmos.bank.v1beta1.MsgSend"]}`),
+			err:  "invalid message type url",
+		},
+		{
+			name: "duplicate allowed message",
+			data: []byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["/cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgSend"]}`),
+			err:  "duplicate allowed message",
+		},
+		{
+			name:     "spend limit",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","spend_limit":{"limit":"1000uosmo","period":"24h"}}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, SpendLimit: &SpendLimit{Limit: "1000uosmo", Period: "24h"}},
+		},
+		{
+			name: "spend limit without coins",
+			data: []byte(`{"version":1,"denom":"` + denom + `","spend_limit":{"period":"24h"}}`),
+			err:  "spend limit must set at least one coin",
+		},
+		{
+			name: "spend limit with invalid period",
+			data: []byte(`{"version":1,"denom":"` + denom + `","spend_limit":{"limit":"1000uosmo","period":"daily"}}`),
+			err:  "invalid spend limit period",
+		},
+		{
+			name:     "validity window",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","not_before":"2024-01-01T00:00:00Z","not_after":"2024-12-31T23:59:59Z"}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, NotBefore: "2024-01-01T00:00:00Z", NotAfter: "2024-12-31T23:59:59Z"},
+		},
+		{
+			name: "invalid not after",
+			data: []byte(`{"version":1,"denom":"` + denom + `","not_after":"2024-12-31"}`),
+			err:  "invalid not after",
+		},
+		{
+			name: "not after before not before",
+			data: []byte(`{"version":1,"denom":"` + denom + `","not_before":"2024-12-31T00:00:00Z","not_after":"2024-01-01T00:00:00Z"}`),
+			err:  "must be later than not before",
+		},
+		{
+			name:     "max uses",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","max_uses":10}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, MaxUses: 10},
+		},
+		{
+			name:     "rate limit",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","rate_limit":{"messages":5,"blocks":100}}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, RateLimit: &RateLimit{Messages: 5, Blocks: 100}},
+		},
+		{
+			name: "rate limit with blocks and seconds",
+			data: []byte(`{"version":1,"denom":"` + denom + `","rate_limit":{"messages":5,"blocks":100,"seconds":60}}`),
+			err:  "rate limit must set exactly one of blocks or seconds",
+		},
+		{
+			name: "rate limit without messages",
+			data: []byte(`{"version":1,"denom":"` + denom + `","rate_limit":{"seconds":60}}`),
+			err:  "rate limit messages must be positive",
+		},
+		{
+			name:     "min holding blocks",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","min_holding_blocks":100}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, MinHoldingBlocks: 100},
+		},
+		{
+			name: "min holding blocks with a cw721 requirement",
+			data: []byte(`{"version":1,"requirements":[{"cw721":{"contract":"` + creator + `"}}],"min_holding_blocks":100}`),
+			err:  "min holding blocks can't be used with the cw721 checker",
+		},
+		{
+			name:     "allow admin messages",
+			data:     []byte(`{"version":1,"denom":"` + denom + `","allow_admin_messages":true}`),
+			expected: NFTAuthenticatorConfig{Version: ConfigVersion1, Denom: denom, AllowAdminMessages: true},
+		},
+		{
+			name: "strict supply with min above one",
+			data: []byte(`{"version":1,"denom":"` + denom + `","strict_supply":true,"min_amount":"2"}`),
+			err:  "strict supply requires a min amount of 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := ParseConfig(tc.data)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, config)
+		})
+	}
+}
+
+// TestIsAmountInBounds checks the balance comparison for NFT and fungible token gating
+func TestIsAmountInBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   NFTAuthenticatorConfig
+		amount   int64
+		expected bool
+	}{
+		{name: "nft holder", config: NFTAuthenticatorConfig{}, amount: 1, expected: true},
+		{name: "nft not held", config: NFTAuthenticatorConfig{}, amount: 0, expected: false},
+		{name: "more than one nft", config: NFTAuthenticatorConfig{}, amount: 2, expected: false},
+		{name: "min only below", config: NFTAuthenticatorConfig{MinAmount: "1000"}, amount: 999, expected: false},
+		{name: "min only equal", config: NFTAuthenticatorConfig{MinAmount: "1000"}, amount: 1000, expected: true},
+		{name: "min only above", config: NFTAuthenticatorConfig{MinAmount: "1000"}, amount: 1_000_000, expected: true},
+		{name: "max only zero", config: NFTAuthenticatorConfig{MaxAmount: "10"}, amount: 0, expected: false},
+		{name: "max only within", config: NFTAuthenticatorConfig{MaxAmount: "10"}, amount: 10, expected: true},
+		{name: "max only above", config: NFTAuthenticatorConfig{MaxAmount: "10"}, amount: 11, expected: false},
+		{name: "range within", config: NFTAuthenticatorConfig{MinAmount: "5", MaxAmount: "10"}, amount: 7, expected: true},
+		{name: "range below", config: NFTAuthenticatorConfig{MinAmount: "5", MaxAmount: "10"}, amount: 4, expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.config.IsAmountInBounds(osmomath.NewInt(tc.amount)))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package nft
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// CW721QueryGasLimit is the most gas a single CW721 ownership query can consume,
+	// it is charged to the transaction like any other gas
+	CW721QueryGasLimit uint64 = 200_000
+)
+
+// cw721QueryMsg are the CW721 queries used to check ownership
+type cw721QueryMsg struct {
+	OwnerOf *cw721OwnerOfQuery `json:"owner_of,omitempty"`
+	Tokens  *cw721TokensQuery  `json:"tokens,omitempty"`
+}
+
+type cw721OwnerOfQuery struct {
+	TokenID string `json:"token_id"`
+}
+
+type cw721TokensQuery struct {
+	Owner string `json:"owner"`
+	Limit uint32 `json:"limit"`
+}
+
+type cw721OwnerOfResponse struct {
+	Owner string `json:"owner"`
+}
+
+type cw721TokensResponse struct {
+	Tokens []string `json:"tokens"`
+}
+
+var _ OwnershipChecker = CW721Checker{}
+
+// CW721Checker checks the ownership of tokens of CW721 contracts with smart queries
+type CW721Checker struct {
+	wasmKeeper WasmKeeper
+}
+
+// NewCW721Checker creates the checker for CW721 requirements, chains with CosmWasm
+// register it with the NFTAuthenticator
+func NewCW721Checker(wasmKeeper WasmKeeper) CW721Checker {
+	return CW721Checker{
+		wasmKeeper: wasmKeeper,
+	}
+}
+
+func (cc CW721Checker) Name() string {
+	return CheckerCW721
+}
+
+// ValidateRequirement ensures the contract exists, CW721 contracts have no admin that can be checked
+func (cc CW721Checker) ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error {
+	contract, err := sdk.AccAddressFromBech32(requirement.CW721.Contract)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid cw721 contract %q: %s", requirement.CW721.Contract, err)
+	}
+	if !cc.wasmKeeper.HasContractInfo(ctx, contract) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "cw721 contract %s does not exist", requirement.CW721.Contract)
+	}
+	return nil
+}
+
+// Owns checks the holder owns the token of the contract or, when no token ID is set,
+// any token of the contract. A failing contract must not fail the transaction, so
+// query errors refuse the holder instead
+func (cc CW721Checker) Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error) {
+	owns, err := cc.ownsCW721(ctx, holder, *requirement.CW721)
+	if err != nil {
+		return false, &RefusedError{
+			HoldingUnknown: true,
+			Reason:         ReasonCW721QueryFailed,
+			Attributes: []sdk.Attribute{
+				sdk.NewAttribute(AttributeKeyDenom, requirement.Key()),
+				sdk.NewAttribute(AttributeKeyError, err.Error()),
+			},
+		}
+	}
+	return owns, nil
+}
+
+func (cc CW721Checker) ownsCW721(ctx sdk.Context, holder sdk.AccAddress, cw721 CW721) (bool, error) {
+	contract, err := sdk.AccAddressFromBech32(cw721.Contract)
+	if err != nil {
+		return false, err
+	}
+
+	if cw721.TokenID != "" {
+		var res cw721OwnerOfResponse
+		if err := cc.queryCW721(ctx, contract, cw721QueryMsg{OwnerOf: &cw721OwnerOfQuery{TokenID: cw721.TokenID}}, &res); err != nil {
+			return false, err
+		}
+		return res.Owner == holder.String(), nil
+	}
+
+	var res cw721TokensResponse
+	if err := cc.queryCW721(ctx, contract, cw721QueryMsg{Tokens: &cw721TokensQuery{Owner: holder.String(), Limit: 1}}, &res); err != nil {
+		return false, err
+	}
+	return len(res.Tokens) > 0, nil
+}
+
+// queryCW721 runs a smart query against the contract with its own gas meter, so a
+// contract that runs out of gas returns an error instead of aborting the transaction.
+// The gas used by the query is then consumed from the transactions gas meter
+func (cc CW721Checker) queryCW721(ctx sdk.Context, contract sdk.AccAddress, query cw721QueryMsg, res interface{}) (err error) {
+	req, err := json.Marshal(query)
+	if err != nil {
+		return err
+	}
+
+	gasMeter := sdk.NewGasMeter(CW721QueryGasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "cw721 query out of gas: %s", outOfGas.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "nft authenticator cw721 query")
+	}()
+
+	bz, err := cc.wasmKeeper.QuerySmart(ctx.WithGasMeter(gasMeter), contract, req)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cw721 query failed: %s", err)
+	}
+	if err := json.Unmarshal(bz, res); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cw721 query response: %s", err)
+	}
+	return nil
+}
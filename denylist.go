@@ -0,0 +1,101 @@
+package nft
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	authenticatortypes "github.com/osmosis-labs/osmosis/v19/x/authenticator/types"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
+)
+
+// IsAdminMessage returns true for the messages a holder could use to take over the
+// account, these are denied unless the config explicitly allows admin messages:
+//   - adding or removing authenticators, which would lock the owner out
+//   - authz grants, which would let the holder act without the authenticator
+//   - the messages of the MsgServer, which manage the state kept for holders and
+//     are denied even when admin messages are allowed
+//   - tokenfactory admin actions on a gating denom, e.g. minting more keys or
+//     changing the admin, and creating new denoms in a gating collection
+//
+// A MsgExec is an admin message when any of the messages it executes is one
+func (c NFTAuthenticatorConfig) IsAdminMessage(msg sdk.Msg) bool {
+	return anyExecutedMessage(msg, func(msg sdk.Msg) bool {
+		return isPrincipalOnlyMessage(msg) || c.isAdminMessage(msg)
+	})
+}
+
+func (c NFTAuthenticatorConfig) isAdminMessage(msg sdk.Msg) bool {
+	switch msg := msg.(type) {
+	case *authenticatortypes.MsgAddAuthenticator, *authenticatortypes.MsgRemoveAuthenticator, *authz.MsgGrant:
+		return true
+	case *tokenfactorytypes.MsgCreateDenom:
+		return c.isGatingDenom(strings.Join([]string{tokenfactorytypes.ModuleDenomPrefix, msg.Sender, msg.Subdenom}, "/"))
+	case *tokenfactorytypes.MsgMint:
+		return c.isGatingDenom(msg.Amount.Denom)
+	case *tokenfactorytypes.MsgBurn:
+		return c.isGatingDenom(msg.Amount.Denom)
+	case *tokenfactorytypes.MsgForceTransfer:
+		return c.isGatingDenom(msg.Amount.Denom)
+	case *tokenfactorytypes.MsgChangeAdmin:
+		return c.isGatingDenom(msg.Denom)
+	case *tokenfactorytypes.MsgSetBeforeSendHook:
+		return c.isGatingDenom(msg.Denom)
+	case *tokenfactorytypes.MsgSetDenomMetadata:
+		return c.isGatingDenom(msg.Metadata.Base)
+	}
+	return false
+}
+
+// isPrincipalOnlyMessage returns true for the messages of the MsgServer, a holder
+// could otherwise e.g. reset their own uses, unrevoke themselves or resume the
+// authenticator
+func isPrincipalOnlyMessage(msg sdk.Msg) bool {
+	return anyExecutedMessage(msg, func(msg sdk.Msg) bool {
+		switch msg.(type) {
+		case *MsgResetUses, *MsgRevokeHolder, *MsgUnrevokeHolder, *MsgPause, *MsgResume:
+			return true
+		}
+		return false
+	})
+}
+
+// anyExecutedMessage returns true when the check is true for the message or, for a
+// MsgExec, for any message it executes including those of nested MsgExecs. A MsgExec
+// whose messages can't be unpacked matches, so it is refused
+func anyExecutedMessage(msg sdk.Msg, check func(sdk.Msg) bool) bool {
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return check(msg)
+	}
+
+	msgs, err := exec.GetMessages()
+	if err != nil {
+		return true
+	}
+	for _, msg := range msgs {
+		if anyExecutedMessage(msg, check) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGatingDenom checks if the bank denom is one of the denoms the config gates on,
+// either directly or as part of a collection
+func (c NFTAuthenticatorConfig) isGatingDenom(denom string) bool {
+	for _, requirement := range c.GetRequirements() {
+		switch {
+		case requirement.Collection != nil:
+			if strings.HasPrefix(denom, requirement.Collection.DenomPrefix()) {
+				return true
+			}
+		case requirement.Denom != "":
+			if denom == requirement.Denom {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package nft
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeAuthenticated is emitted when a signer is authenticated and records
+	// which denoms the signer was authenticated with
+	EventTypeAuthenticated = "nft_authenticated"
+
+	// EventTypeAuthenticationRefused is emitted when a signer would have been
+	// authenticated but a rule in the config refused the authentication
+	EventTypeAuthenticationRefused = "nft_authentication_refused"
+
+	// EventTypeUsesReset is emitted when the principal resets the uses of a holder
+	EventTypeUsesReset = "nft_uses_reset"
+
+	// EventTypeHolderRevoked and EventTypeHolderUnrevoked are emitted when the principal
+	// adds a holder or public key to the revocation list or removes it
+	EventTypeHolderRevoked   = "nft_holder_revoked"
+	EventTypeHolderUnrevoked = "nft_holder_unrevoked"
+
+	// EventTypePaused and EventTypeResumed are emitted when the principal pauses or
+	// resumes the NFTAuthenticator
+	EventTypePaused  = "nft_authenticator_paused"
+	EventTypeResumed = "nft_authenticator_resumed"
+
+	AttributeKeyAccount = "account"
+	AttributeKeySigner  = "signer"
+	AttributeKeyReason  = "reason"
+	AttributeKeyDenom   = "denom"
+	AttributeKeySupply  = "supply"
+	AttributeKeyError   = "error"
+	AttributeKeyMsgType = "msg_type"
+	// AttributeKeyNotBefore and AttributeKeyNotAfter record the validity window of
+	// the config when it refused the authentication
+	AttributeKeyNotBefore = "not_before"
+	AttributeKeyNotAfter  = "not_after"
+	AttributeKeyUses      = "uses"
+	AttributeKeyMessages  = "messages"
+	AttributeKeyHeldSince = "held_since"
+	AttributeKeyPubKey    = "pub_key"
+	// AttributeKeyConfigHash identifies the NFTAuthenticator of the account that
+	// authenticated the signer by the hash of its config
+	AttributeKeyConfigHash = "config_hash"
+	// AttributeKeyMsgIndex is the index in the tx of the message the signer was
+	// authenticated for
+	AttributeKeyMsgIndex = "msg_index"
+
+	// ReasonSupplyNotOne is used when strict supply is enabled and the total
+	// supply of the denom is no longer exactly one
+	ReasonSupplyNotOne = "supply_not_one"
+
+	// ReasonCW721QueryFailed is used when the ownership query to a CW721 contract
+	// errors or runs out of gas
+	ReasonCW721QueryFailed = "cw721_query_failed"
+
+	// ReasonPaused is used while the principal paused the NFTAuthenticator, every
+	// holder is refused until it is resumed
+	ReasonPaused = "paused"
+
+	// ReasonInvalidAuthenticationData is used when the authentication data isn't
+	// signature data or the signers don't line up with the signatures
+	ReasonInvalidAuthenticationData = "invalid_authentication_data"
+
+	// ReasonNoSignatures is used when the tx has no signature for the account
+	ReasonNoSignatures = "no_signatures"
+
+	// ReasonMissingPubKey is used when a signature has no public key
+	ReasonMissingPubKey = "missing_pubkey"
+
+	// ReasonUnsupportedKeyType is used when a signature was made with a key type
+	// the authenticator can't verify
+	ReasonUnsupportedKeyType = "unsupported_key_type"
+
+	// ReasonMessageNotAllowed is used when the message type isn't in the allowed
+	// messages of the config
+	ReasonMessageNotAllowed = "message_not_allowed"
+
+	// ReasonAdminMessageDenied is used when a holder signs a message that could take
+	// over the account and the config doesn't allow admin messages
+	ReasonAdminMessageDenied = "admin_message_denied"
+
+	// ReasonNotYetValid is used when the block time is before the not_before of the config
+	ReasonNotYetValid = "not_yet_valid"
+
+	// ReasonExpired is used when the block time is after the not_after of the config,
+	// the pass has lapsed whatever the holder holds
+	ReasonExpired = "expired"
+
+	// ReasonQuotaExhausted fails the tx when its messages would take the holder past
+	// the max_uses of the config, until the principal resets their uses
+	ReasonQuotaExhausted = "quota_exhausted"
+
+	// ReasonHolderRevoked blocks the execution when the principal revoked the address
+	// of the holder or a public key it signed with, whatever the holder holds
+	ReasonHolderRevoked = "holder_revoked"
+
+	// ReasonRateLimited fails the tx when its messages would take the holder past the
+	// messages of the rate limit within the window
+	ReasonRateLimited = "rate_limited"
+
+	// ReasonHoldingPeriodNotMet is used when the holder acquired the denom less than
+	// min_holding_blocks ago, or before the denom was gated for that long
+	ReasonHoldingPeriodNotMet = "holding_period_not_met"
+
+	// ReasonDenomAdminChanged is used when the holder holds a denom of a collection
+	// whose tokenfactory admin is no longer the creator of the collection
+	ReasonDenomAdminChanged = "denom_admin_changed"
+
+	// ReasonOwnershipCheckFailed is used when an ownership checker returns an
+	// error without a more specific reason
+	ReasonOwnershipCheckFailed = "ownership_check_failed"
+)
+
+// emitAuthenticated records the denoms the signer was authenticated with for the message
+func emitAuthenticated(ctx sdk.Context, account sdk.AccAddress, signer sdk.AccAddress, configHash string, msgIndex int, denoms []string) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyAccount, account.String()),
+		sdk.NewAttribute(AttributeKeySigner, signer.String()),
+		sdk.NewAttribute(AttributeKeyConfigHash, configHash),
+		sdk.NewAttribute(AttributeKeyMsgIndex, strconv.Itoa(msgIndex)),
+	}
+	for _, denom := range denoms {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyDenom, denom))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeAuthenticated, attributes...))
+}
+
+// emitAuthenticationRefused records why the NFTAuthenticator refused to authenticate the signer
+func emitAuthenticationRefused(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	signer sdk.AccAddress,
+	reason string,
+	attributes ...sdk.Attribute,
+) {
+	attributes = append([]sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyAccount, account.String()),
+		sdk.NewAttribute(AttributeKeySigner, signer.String()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+	}, attributes...)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeAuthenticationRefused, attributes...))
+}
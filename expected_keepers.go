@@ -0,0 +1,28 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// NFTKeeper defines the subset of the Cosmos SDK x/nft keeper used to check
+// the ownership of NFTs
+type NFTKeeper interface {
+	HasClass(ctx sdk.Context, classID string) bool
+	HasNFT(ctx sdk.Context, classID, id string) bool
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+	GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64
+}
+
+// WasmKeeper defines the subset of the wasm keeper used to query the ownership
+// of CW721 tokens
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
+
+// TransferKeeper defines the subset of the ibc-transfer keeper used to resolve
+// the denom traces of IBC vouchers
+type TransferKeeper interface {
+	HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool
+}
@@ -0,0 +1,209 @@
+package nft
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/utils"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
+)
+
+var _ banktypes.BankHooks = HoldingTracker{}
+
+// holdingPrefix is the prefix of the heights recorded by the HoldingTracker
+var holdingPrefix = utils.BuildKey("NFTHolding")
+
+// gatedDenomPrefix and gatedCollectionPrefix are the prefixes of the denoms and the
+// collections gated by configs with a min_holding_blocks, kept in the state store of
+// the NFTAuthenticator
+var (
+	gatedDenomPrefix      = utils.BuildKey("NFTGatedDenom")
+	gatedCollectionPrefix = utils.BuildKey("NFTGatedCollection")
+)
+
+// gatedHolding counts the configs gating a denom or a collection on their holding period
+type gatedHolding struct {
+	Configs uint64 `json:"configs"`
+	// Since is the height at which the first of the configs was added, holdings have
+	// been recorded without interruption since then
+	Since int64 `json:"since"`
+}
+
+// HoldingTracker is a bank hook recording the height at which an address acquired a
+// denom, the BankChecker uses it to enforce the min_holding_blocks of a config. Only
+// the denoms gated by a config with a min_holding_blocks are recorded, so other
+// transfers don't pay for the tracker
+type HoldingTracker struct {
+	storeKey      sdk.StoreKey
+	stateStoreKey sdk.StoreKey
+	bankKeeper    bankkeeper.Keeper
+}
+
+// NewHoldingTracker creates the tracker, the store key must be one the chain mounts
+// for the tracker. Writes made while a tx executes are lost for the authenticator
+// store, so it can't be used. The tracker reads the gated denoms from the state store
+// the NFTAuthenticator was created with, which can be the same store. Chains add the
+// tracker to the bank hooks, e.g. banktypes.NewMultiBankHooks(tokenFactoryHooks, tracker)
+func NewHoldingTracker(storeKey sdk.StoreKey, stateStoreKey sdk.StoreKey, bankKeeper bankkeeper.Keeper) HoldingTracker {
+	return HoldingTracker{
+		storeKey:      storeKey,
+		stateStoreKey: stateStoreKey,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+func getHoldingKey(holder sdk.AccAddress, denom string) []byte {
+	return utils.BuildKey(holder, denom)
+}
+
+func (t HoldingTracker) getStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(t.storeKey), holdingPrefix)
+}
+
+// HeldSince returns the height since which the holder has held the denom, false is
+// returned when the denom isn't gated. Every transfer of a gated denom is recorded, so
+// a holder without a height recorded held it since before the denom was gated and is
+// considered to hold it since the gate height. So is a height recorded before the
+// denom was last gated, the tracker stopped recording the denom in between
+func (t HoldingTracker) HeldSince(ctx sdk.Context, holder sdk.AccAddress, denom string) (int64, bool) {
+	gatedSince, gated := t.gatedSince(ctx, denom)
+	if !gated {
+		return 0, false
+	}
+
+	var height int64
+	if !getState(t.getStore(ctx), getHoldingKey(holder, denom), &height) || height < gatedSince {
+		return gatedSince, true
+	}
+	return height, true
+}
+
+// TrackBeforeSend is called before the balances change. A holder that sends its
+// whole balance of a denom stops holding it and the height is recorded when the
+// receiver didn't hold the denom yet or has no height recorded, so holding is
+// measured from the last time the balance went from zero to positive
+func (t HoldingTracker) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	if from.Equals(to) {
+		return
+	}
+
+	store := t.getStore(ctx)
+	for _, coin := range amount {
+		if !coin.IsPositive() {
+			continue
+		}
+		if _, gated := t.gatedSince(ctx, coin.Denom); !gated {
+			continue
+		}
+
+		if t.bankKeeper.GetBalance(ctx, from, coin.Denom).Amount.LTE(coin.Amount) {
+			store.Delete(getHoldingKey(from, coin.Denom))
+		}
+
+		// Sends that don't call the hooks, e.g. MsgMultiSend, can leave a height
+		// behind for an address that no longer holds the denom
+		key := getHoldingKey(to, coin.Denom)
+		if store.Has(key) && t.bankKeeper.GetBalance(ctx, to, coin.Denom).IsPositive() {
+			continue
+		}
+		setState(store, key, ctx.BlockHeight())
+	}
+}
+
+// BlockBeforeSend never blocks a send, the tracker only records holdings
+func (t HoldingTracker) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	return nil
+}
+
+// gatedSince returns the height since which the denom has been gated without
+// interruption, by the denom itself or by a collection it belongs to. Only the
+// collections of the creator of a tokenfactory denom are iterated
+func (t HoldingTracker) gatedSince(ctx sdk.Context, denom string) (int64, bool) {
+	kvStore := ctx.KVStore(t.stateStoreKey)
+
+	var since int64
+	var gate gatedHolding
+	gated := getState(prefix.NewStore(kvStore, gatedDenomPrefix), []byte(denom), &gate)
+	if gated {
+		since = gate.Since
+	}
+
+	creator, subdenom, err := tokenfactorytypes.DeconstructDenom(denom)
+	if err != nil {
+		return since, gated
+	}
+	collections := prefix.NewStore(prefix.NewStore(kvStore, gatedCollectionPrefix), []byte(Collection{Creator: creator}.DenomPrefix()))
+	iterator := collections.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if !strings.HasPrefix(subdenom, string(iterator.Key())) {
+			continue
+		}
+		if err := json.Unmarshal(iterator.Value(), &gate); err != nil {
+			continue
+		}
+		if !gated || gate.Since < since {
+			since = gate.Since
+		}
+		gated = true
+	}
+	return since, gated
+}
+
+// gateHoldings adds delta to the configs gating each denom of the config, so the
+// HoldingTracker records them while at least one config has a holding period. For a
+// requirement with ibc_paths only the vouchers are gated, the base denom isn't counted
+func gateHoldings(kvStore sdk.KVStore, height int64, config NFTAuthenticatorConfig, delta int) {
+	denoms := prefix.NewStore(kvStore, gatedDenomPrefix)
+	collections := prefix.NewStore(kvStore, gatedCollectionPrefix)
+	for _, requirement := range config.GetRequirements() {
+		if requirement.Collection != nil {
+			addGate(collections, []byte(requirement.Collection.DenomPrefix()), height, delta)
+			continue
+		}
+		if len(requirement.IBCPaths) == 0 {
+			addGate(denoms, []byte(requirement.Denom), height, delta)
+		}
+		for _, trace := range requirement.DenomTraces() {
+			addGate(denoms, []byte(trace.IBCDenom()), height, delta)
+		}
+	}
+}
+
+// gatedKey is set once the denoms of the config of the authenticator are gated
+var gatedKey = []byte("gated")
+
+// gateDenoms gates the denoms of a config with a holding period the first time the
+// execution of a tx is confirmed for the authenticator, which is the tx adding it as
+// the writes made when it is added are discarded
+func (na NFTAuthenticator) gateDenoms(ctx sdk.Context, store prefix.Store) {
+	if na.config.MinHoldingBlocks == 0 || store.Has(gatedKey) {
+		return
+	}
+	gateHoldings(ctx.KVStore(na.stateStoreKey), ctx.BlockHeight(), na.config, 1)
+	store.Set(gatedKey, []byte{1})
+}
+
+// addGate adds delta to the configs gating the key and deletes it once no config is left
+func addGate(store prefix.Store, key []byte, height int64, delta int) {
+	var gate gatedHolding
+	if !getState(store, key, &gate) {
+		gate.Since = height
+	}
+
+	switch {
+	case delta > 0:
+		gate.Configs += uint64(delta)
+	case uint64(-delta) < gate.Configs:
+		gate.Configs -= uint64(-delta)
+	default:
+		store.Delete(key)
+		return
+	}
+	setState(store, key, gate)
+}
@@ -0,0 +1,65 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
+)
+
+var _ OwnershipChecker = IBCChecker{}
+
+// IBCChecker checks the bank balances of the holder for the vouchers of a base denom
+// sent over the allowed IBC paths
+type IBCChecker struct {
+	bank           BankChecker
+	transferKeeper TransferKeeper
+}
+
+// NewIBCChecker creates the checker for requirements with ibc_paths, chains with
+// ibc-transfer register it with the NFTAuthenticator
+func NewIBCChecker(bank BankChecker, transferKeeper TransferKeeper) IBCChecker {
+	return IBCChecker{
+		bank:           bank,
+		transferKeeper: transferKeeper,
+	}
+}
+
+func (ic IBCChecker) Name() string {
+	return CheckerIBC
+}
+
+// ValidateRequirement ensures a voucher has been received over every allowed path. A
+// base denom of this chain must be a tokenfactory denom the account is the admin of,
+// the admin of a denom from another chain can't be checked
+func (ic IBCChecker) ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error {
+	if err := ic.bank.validateHoldingTracker(config); err != nil {
+		return err
+	}
+
+	for _, trace := range requirement.DenomTraces() {
+		if !ic.transferKeeper.HasDenomTrace(ctx, trace.Hash()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "ibc denom trace %s/%s does not exist", trace.Path, trace.BaseDenom)
+		}
+	}
+
+	if _, _, err := tokenfactorytypes.DeconstructDenom(requirement.Denom); err == nil {
+		return ic.bank.validateDenomAdmin(ctx, account, requirement.Denom)
+	}
+	if _, found := ic.bank.bankKeeper.GetDenomMetaData(ctx, requirement.Denom); found || ic.bank.bankKeeper.HasSupply(ctx, requirement.Denom) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s exists on this chain and is not a tokenfactory denom", requirement.Denom)
+	}
+	return nil
+}
+
+// Owns checks the holders balance of the vouchers received over the allowed paths,
+// holding any one of them within the configured amount is enough. The base denom
+// itself isn't counted, requirements without ibc_paths are checked by the bank checker
+func (ic IBCChecker) Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error) {
+	for _, trace := range requirement.DenomTraces() {
+		balance := ic.bank.bankKeeper.GetBalance(ctx, holder, trace.IBCDenom())
+		if accepted, err := ic.bank.isBalanceAccepted(ctx, holder, config, balance); accepted || err != nil {
+			return accepted, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,171 @@
+package nft
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	authenticatorkeeper "github.com/osmosis-labs/osmosis/v19/x/authenticator/keeper"
+)
+
+var _ MsgServer = msgServer{}
+
+type msgServer struct {
+	authenticatorKeeper *authenticatorkeeper.Keeper
+	storeKey            sdk.StoreKey
+}
+
+// NewMsgServerImpl creates the msg server, the store key must be the state store key
+// the NFTAuthenticator was created with. Chains register it with RegisterMsgServer
+// after registering the messages with RegisterInterfaces
+func NewMsgServerImpl(authenticatorKeeper *authenticatorkeeper.Keeper, storeKey sdk.StoreKey) MsgServer {
+	return msgServer{
+		authenticatorKeeper: authenticatorKeeper,
+		storeKey:            storeKey,
+	}
+}
+
+// ResetUses sets the uses of the holder back to zero
+func (m msgServer) ResetUses(goCtx context.Context, msg *MsgResetUses) (*MsgResetUsesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.getStore(ctx, sender, msg.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	store.Delete(getUsesKey(msg.Holder))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeUsesReset,
+		sdk.NewAttribute(AttributeKeyAccount, msg.Sender),
+		sdk.NewAttribute(AttributeKeySigner, msg.Holder),
+	))
+	return &MsgResetUsesResponse{}, nil
+}
+
+// RevokeHolder adds the holder or public key to the revocation list
+func (m msgServer) RevokeHolder(goCtx context.Context, msg *MsgRevokeHolder) (*MsgRevokeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.getStore(ctx, sender, msg.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	revoked := getRevokedHolders(store).revoke(msg.Holder, msg.PubKey)
+	if len(revoked.Holders)+len(revoked.PubKeys) > MaxRevokedHolders {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the revocation list can't hold more than %d entries", MaxRevokedHolders)
+	}
+	setRevokedHolders(store, revoked)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeHolderRevoked, revocationAttributes(msg.Sender, msg.Holder, msg.PubKey)...))
+	return &MsgRevokeHolderResponse{}, nil
+}
+
+// UnrevokeHolder removes the holder or public key from the revocation list
+func (m msgServer) UnrevokeHolder(goCtx context.Context, msg *MsgUnrevokeHolder) (*MsgUnrevokeHolderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.getStore(ctx, sender, msg.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	setRevokedHolders(store, getRevokedHolders(store).unrevoke(msg.Holder, msg.PubKey))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeHolderUnrevoked, revocationAttributes(msg.Sender, msg.Holder, msg.PubKey)...))
+	return &MsgUnrevokeHolderResponse{}, nil
+}
+
+// Pause sets the paused flag of the authenticator, holders are refused until it is resumed
+func (m msgServer) Pause(goCtx context.Context, msg *MsgPause) (*MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.getStore(ctx, sender, msg.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	store.Set(pausedKey, []byte{1})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypePaused,
+		sdk.NewAttribute(AttributeKeyAccount, msg.Sender),
+	))
+	return &MsgPauseResponse{}, nil
+}
+
+// Resume clears the paused flag of the authenticator
+func (m msgServer) Resume(goCtx context.Context, msg *MsgResume) (*MsgResumeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := m.getStore(ctx, sender, msg.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	store.Delete(pausedKey)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeResumed,
+		sdk.NewAttribute(AttributeKeyAccount, msg.Sender),
+	))
+	return &MsgResumeResponse{}, nil
+}
+
+// revocationAttributes records the account and the holder or public key of a revocation
+func revocationAttributes(account, holder string, pubKey []byte) []sdk.Attribute {
+	attributes := []sdk.Attribute{sdk.NewAttribute(AttributeKeyAccount, account)}
+	if holder != "" {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeySigner, holder))
+	}
+	if len(pubKey) > 0 {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyPubKey, hex.EncodeToString(pubKey)))
+	}
+	return attributes
+}
+
+// getStore returns the state of the NFTAuthenticator of the account with the id
+func (m msgServer) getStore(ctx sdk.Context, account sdk.AccAddress, id uint64) (prefix.Store, error) {
+	authenticatorID, err := getAuthenticatorID(ctx, m.authenticatorKeeper, account, id)
+	if err != nil {
+		return prefix.Store{}, err
+	}
+	return getAccountStore(ctx.KVStore(m.storeKey), account, authenticatorID), nil
+}
+
+// getAuthenticatorID looks up the NFTAuthenticator of the account with the id in the
+// authenticator module and returns the id its state is kept under
+func getAuthenticatorID(ctx sdk.Context, authenticatorKeeper *authenticatorkeeper.Keeper, account sdk.AccAddress, id uint64) (string, error) {
+	authenticators, err := authenticatorKeeper.GetAuthenticatorDataForAccount(ctx, account)
+	if err != nil {
+		return "", err
+	}
+
+	for _, accountAuthenticator := range authenticators {
+		if accountAuthenticator.Id != id {
+			continue
+		}
+		if accountAuthenticator.Type != NFTAuthenticatorType {
+			return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator %d is a %s, not a %s", id, accountAuthenticator.Type, NFTAuthenticatorType)
+		}
+		return authenticatorID(accountAuthenticator.Data), nil
+	}
+	return "", sdkerrors.Wrapf(sdkerrors.ErrNotFound, "authenticator %d not found for account %s", id, account)
+}
@@ -0,0 +1,144 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// Message types returned by Type, they are part of the amino json sign bytes
+const (
+	TypeMsgResetUses      = "reset_uses"
+	TypeMsgRevokeHolder   = "revoke_holder"
+	TypeMsgUnrevokeHolder = "unrevoke_holder"
+	TypeMsgPause          = "pause"
+	TypeMsgResume         = "resume"
+)
+
+// Compile time type assertion for the messages of the msg server
+var (
+	_ sdk.Msg = &MsgResetUses{}
+	_ sdk.Msg = &MsgRevokeHolder{}
+	_ sdk.Msg = &MsgUnrevokeHolder{}
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgResume{}
+
+	_ legacytx.LegacyMsg = &MsgResetUses{}
+	_ legacytx.LegacyMsg = &MsgRevokeHolder{}
+	_ legacytx.LegacyMsg = &MsgUnrevokeHolder{}
+	_ legacytx.LegacyMsg = &MsgPause{}
+	_ legacytx.LegacyMsg = &MsgResume{}
+)
+
+func (m MsgResetUses) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %q: %s", m.Sender, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder %q: %s", m.Holder, err)
+	}
+	return nil
+}
+
+// GetSigners returns the account, only the principal can reset the uses of its holders
+func (m MsgResetUses) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgResetUses) Route() string { return RouterKey }
+func (m MsgResetUses) Type() string  { return TypeMsgResetUses }
+
+func (m MsgResetUses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeHolder) ValidateBasic() error {
+	return validateRevocation(m.Sender, m.Holder, m.PubKey)
+}
+
+// GetSigners returns the account, only the principal can revoke its holders
+func (m MsgRevokeHolder) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgRevokeHolder) Route() string { return RouterKey }
+func (m MsgRevokeHolder) Type() string  { return TypeMsgRevokeHolder }
+
+func (m MsgRevokeHolder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUnrevokeHolder) ValidateBasic() error {
+	return validateRevocation(m.Sender, m.Holder, m.PubKey)
+}
+
+// GetSigners returns the account, only the principal can unrevoke its holders
+func (m MsgUnrevokeHolder) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgUnrevokeHolder) Route() string { return RouterKey }
+func (m MsgUnrevokeHolder) Type() string  { return TypeMsgUnrevokeHolder }
+
+func (m MsgUnrevokeHolder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %q: %s", m.Sender, err)
+	}
+	return nil
+}
+
+// GetSigners returns the account, only the principal can pause the authenticator
+func (m MsgPause) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgPause) Route() string { return RouterKey }
+func (m MsgPause) Type() string  { return TypeMsgPause }
+
+func (m MsgPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgResume) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %q: %s", m.Sender, err)
+	}
+	return nil
+}
+
+// GetSigners returns the account, only the principal can resume the authenticator
+func (m MsgResume) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func (m MsgResume) Route() string { return RouterKey }
+func (m MsgResume) Type() string  { return TypeMsgResume }
+
+func (m MsgResume) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// validateRevocation checks that a revocation names exactly one of a holder or a public key
+func validateRevocation(sender, holder string, pubKey []byte) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender %q: %s", sender, err)
+	}
+	if (holder == "") == (len(pubKey) == 0) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "exactly one of holder or pub key must be set")
+	}
+	if holder != "" {
+		if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder %q: %s", holder, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package nft
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMsgSignBytes checks that the messages of the msg server can be signed with
+// amino json
+func TestMsgSignBytes(t *testing.T) {
+	sender := sdk.AccAddress([]byte("principal___________")).String()
+	holder := sdk.AccAddress([]byte("holder______________")).String()
+
+	msgs := []legacytx.LegacyMsg{
+		&MsgResetUses{Sender: sender, AuthenticatorId: 1, Holder: holder},
+		&MsgRevokeHolder{Sender: sender, AuthenticatorId: 1, Holder: holder},
+		&MsgUnrevokeHolder{Sender: sender, AuthenticatorId: 1, PubKey: []byte{0x02, 0x01}},
+		&MsgPause{Sender: sender, AuthenticatorId: 1},
+		&MsgResume{Sender: sender, AuthenticatorId: 1},
+	}
+	for _, msg := range msgs {
+		signBytes := legacytx.StdSignBytes("osmosis-1", 1, 0, 0, legacytx.NewStdFee(200000, nil), []sdk.Msg{msg}, "")
+		require.Contains(t, string(signBytes), `"type":"nftauthenticator/`+reflect.TypeOf(msg).Elem().Name()+`"`)
+		require.Contains(t, string(signBytes), `"sender":"`+sender+`"`)
+		require.Equal(t, RouterKey, msg.Route())
+	}
+}
+
+// TestMsgTxDecoder checks that a tx with the messages of the msg server goes through
+// the TxDecoder of the app, which rejects the unknown fields of the messages
+func TestMsgTxDecoder(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	sender := sdk.AccAddress([]byte("principal___________")).String()
+	holder := sdk.AccAddress([]byte("holder______________")).String()
+	msgs := []sdk.Msg{
+		&MsgResetUses{Sender: sender, AuthenticatorId: 1, Holder: holder},
+		&MsgRevokeHolder{Sender: sender, AuthenticatorId: 1, Holder: holder},
+		&MsgUnrevokeHolder{Sender: sender, AuthenticatorId: 1, PubKey: []byte{0x02, 0x01}},
+		&MsgPause{Sender: sender, AuthenticatorId: 1},
+		&MsgResume{Sender: sender, AuthenticatorId: 1},
+	}
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, msgs, tx.GetMsgs())
+}
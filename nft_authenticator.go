@@ -1,14 +1,24 @@
 package nft
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	"github.com/osmosis-labs/osmosis/osmomath"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/keeper"
 
 	"github.com/osmosis-labs/osmosis/v19/x/authenticator/authenticator"
 	"github.com/osmosis-labs/osmosis/v19/x/authenticator/iface"
+	authenticatorkeeper "github.com/osmosis-labs/osmosis/v19/x/authenticator/keeper"
 )
 
 // Compile time type assertion NFTAuthenticator struct and data
@@ -18,6 +28,9 @@ var _ iface.AuthenticatorData = &NFTAuthData{}
 const (
 	// NFTAuthenticatorType represents a type of authenticator
 	NFTAuthenticatorType = "NFTAuthenticator"
+
+	// DenomCheckGas is consumed for every denom checked while authenticating
+	DenomCheckGas uint64 = 500
 )
 
 // NFTAuthenticator struct contains all the necessary data to enable the
@@ -25,8 +38,15 @@ const (
 type NFTAuthenticator struct {
 	bankKeeper  bankkeeper.Keeper
 	tokenKeeper tokenfactorykeeper.Keeper
-	sva         authenticator.SignatureVerificationAuthenticator
-	denom       string
+	storeKey    sdk.StoreKey
+	// stateStoreKey is the store of the state kept for the holders
+	stateStoreKey sdk.StoreKey
+	checkers      map[string]OwnershipChecker
+	sva           authenticator.SignatureVerificationAuthenticator
+	// authenticatorKeeper is set with WithAuthenticatorKeeper
+	authenticatorKeeper *authenticatorkeeper.Keeper
+	config              NFTAuthenticatorConfig
+	id                  string
 }
 
 // Type returns the NFTAuthenticatorType, this is used when an authenticator is added
@@ -36,36 +56,93 @@ func (na NFTAuthenticator) Type() string {
 }
 
 func (na NFTAuthenticator) StaticGas() uint64 {
-	// For every NFTAuthenticator we consume 1000 gas, plus signature verification
-	return 1000
+	// Every NFTAuthenticator reads the pause flag first, which consumes the 1000 gas
+	// of a store read, plus signature verification
+	return 0
 }
 
-// NewNFTAuthenticator creates a new with the correct keeper needed to function
+// NewNFTAuthenticator creates a new with the correct keepers needed to function
 // correctly, this is added to the authentication manager when the applciation is
-// started
+// started. The BankChecker is always registered, other ownership checkers such as
+// the NFTModuleChecker and CW721Checker are registered by passing them in. The
+// store key must be the authenticator store key, the execution pending for a tx is
+// kept there as it is the only store written while the tx is authenticated. The
+// state store key must be one the chain mounts for the NFTAuthenticator, the state
+// kept for the holders, such as spend limits, is kept there under the
+// NFTAuthenticatorType prefix. The bank store key is passed to the BankChecker
 func NewNFTAuthenticator(
 	bankKeeper bankkeeper.Keeper,
+	bankStoreKey sdk.StoreKey,
+	tokenKeeper tokenfactorykeeper.Keeper,
+	storeKey sdk.StoreKey,
+	stateStoreKey sdk.StoreKey,
 	sva authenticator.SignatureVerificationAuthenticator,
+	checkers ...OwnershipChecker,
 ) NFTAuthenticator {
+	registered := map[string]OwnershipChecker{
+		CheckerBank: NewBankChecker(bankKeeper, bankStoreKey, tokenKeeper),
+	}
+	for _, checker := range checkers {
+		if _, ok := registered[checker.Name()]; ok {
+			panic(fmt.Sprintf("ownership checker %s is already registered", checker.Name()))
+		}
+		registered[checker.Name()] = checker
+	}
+
 	return NFTAuthenticator{
-		bankKeeper: bankKeeper,
-		sva:        sva,
+		bankKeeper:    bankKeeper,
+		tokenKeeper:   tokenKeeper,
+		storeKey:      storeKey,
+		stateStoreKey: stateStoreKey,
+		checkers:      registered,
+		sva:           sva,
 	}
 }
 
+// WithHoldingTracker returns the authenticator with the tracker set on its BankChecker,
+// configs with min_holding_blocks can only be added once it is set. An IBCChecker
+// is given a BankChecker with the tracker when it is created
+func (na NFTAuthenticator) WithHoldingTracker(tracker HoldingTracker) NFTAuthenticator {
+	checkers := make(map[string]OwnershipChecker, len(na.checkers))
+	for name, checker := range na.checkers {
+		checkers[name] = checker
+	}
+	checkers[CheckerBank] = checkers[CheckerBank].(BankChecker).WithHoldingTracker(tracker)
+	na.checkers = checkers
+	return na
+}
+
+// WithAuthenticatorKeeper returns the authenticator with the keeper of the authenticator
+// module, NFTAuthenticators can only be added once it is set. It is used to reject a
+// config the account already has, as the state is kept per config, and to delete the
+// state of a removed authenticator so adding it again starts afresh
+func (na NFTAuthenticator) WithAuthenticatorKeeper(authenticatorKeeper *authenticatorkeeper.Keeper) NFTAuthenticator {
+	na.authenticatorKeeper = authenticatorKeeper
+	return na
+}
+
 // Initialize is used after we get authenticator data from the store,
-// we initialize data
+// we parse the config from the data, see ParseConfig for the accepted formats
 func (na NFTAuthenticator) Initialize(
 	data []byte,
 ) (iface.Authenticator, error) {
-	na.denom = string(data)
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	na.config = config
+	na.id = authenticatorID(data)
 	return na, nil
 }
 
-// NFTSignatureData is used to package all the signature data and the tx
+// NFTAuthData is used to package all the signature data and the tx
 // for use in the Authenticate function, since we wrap the SignatureVerificationAuthenticator
-// we use the same signature data
-type NFTAuthData = authenticator.SignatureData
+// we use the same signature data. The index of the message lets ConfirmExecution check
+// the holders that authenticated that message
+type NFTAuthData struct {
+	authenticator.SignatureData
+	MessageIndex int
+}
 
 // GetAuthenticationData parses the signers and signatures from a transactiom
 // then returns a indexed list of both signers and signatures, we use the SignatureVerificationAuthenticator
@@ -77,57 +154,406 @@ func (na NFTAuthenticator) GetAuthenticationData(
 	messageIndex int,
 	simulate bool,
 ) (iface.AuthenticatorData, error) {
-	return na.sva.GetAuthenticationData(ctx, tx, messageIndex, simulate)
+	authData, err := na.sva.GetAuthenticationData(ctx, tx, messageIndex, simulate)
+	if err != nil {
+		return nil, err
+	}
+	signatureData, ok := authData.(authenticator.SignatureData)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected authentication data %T", authData)
+	}
+	return NFTAuthData{SignatureData: signatureData, MessageIndex: messageIndex}, nil
 }
 
-// Authenticate takes an NFTVerificationData struct and validates
-// each signer and signature using signature verification, then
-// ensure that the signer has the NFT that enables the use of the
-// original creators account
+// Authenticate takes an NFTVerificationData struct and validates the
+// signatures selected by the signers mode of the config using signature
+// verification, then ensure that the signers have the NFT that enables the
+// use of the original creators account, the denoms that matched are recorded
+// in a nft_authenticated event. A signer that holds the gating tokens but is
+// refused by a rule of the config is not authenticated, the reason is recorded
+// in a nft_authentication_refused event and the other authenticators of the
+// account, e.g. the one of the owner, are tried
 func (na NFTAuthenticator) Authenticate(
 	ctx sdk.Context,
 	account sdk.AccAddress,
 	msg sdk.Msg,
 	authenticationData iface.AuthenticatorData,
 ) iface.AuthenticationResult {
-	sigPubKey := authenticationData.(authenticator.SignatureData).Signatures[0].PubKey
+	// While the principal paused the authenticator nobody is authenticated, there is
+	// no need to verify any signature or look up what the signers hold
+	if isPaused(na.getStore(ctx, account)) {
+		return refuse(ctx, account, nil, ReasonPaused)
+	}
+
+	// Malformed data must never panic inside the ante handler
+	authData, ok := authenticationData.(NFTAuthData)
+	if !ok {
+		return refuse(ctx, account, nil, ReasonInvalidAuthenticationData,
+			sdk.NewAttribute(AttributeKeyError, fmt.Sprintf("unexpected authentication data %T", authenticationData)),
+		)
+	}
+	signatureData := authData.SignatureData
+	if len(signatureData.Signers) != len(signatureData.Signatures) {
+		return refuse(ctx, account, nil, ReasonInvalidAuthenticationData,
+			sdk.NewAttribute(AttributeKeyError, fmt.Sprintf("%d signers for %d signatures", len(signatureData.Signers), len(signatureData.Signatures))),
+		)
+	}
+
+	// By default only the signature for the account is relevant, any other signer
+	// of the tx, e.g. a fee payer, is authenticated by their own account
+	indexes := make([]int, 0, len(signatureData.Signatures))
+	for i := range signatureData.Signatures {
+		if na.config.GetSigners() != SignersOne || signatureData.Signers[i].Equals(account) {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		return refuse(ctx, account, nil, ReasonNoSignatures)
+	}
+
+	var holders []sdk.AccAddress
+	for _, i := range indexes {
+		signerAddress, authenticationResult := na.authenticateSignature(ctx, account, msg, signatureData, i)
+		if !authenticationResult.IsAuthenticated() {
+			return authenticationResult
+		}
+
+		// The account signing for itself isn't a holder, it is authenticated by its own
+		// authenticators whatever it holds, so it is never refused
+		var matched []string
+		var refused *RefusedError
+		if !signerAddress.Equals(account) {
+			// If the signer doesn't hold the configured denoms they can't authenticate alone
+			matched, refused = na.matchRequirements(ctx, account, signerAddress)
+		}
+		switch {
+		case len(matched) > 0:
+			// A holder can still be refused by the config
+			refused = na.refuseHolder(ctx, msg)
+		case refused == nil:
+			if na.config.GetSigners() == SignersAny {
+				continue
+			}
+			return iface.NotAuthenticated()
+		}
+
+		if refused != nil {
+			emitAuthenticationRefused(ctx, account, signerAddress, refused.Reason, refused.Attributes...)
+			if na.config.GetSigners() == SignersAny {
+				continue
+			}
+			return iface.NotAuthenticated()
+		}
+
+		// Successful authentication for the NFT holder
+		emitAuthenticated(ctx, account, signerAddress, na.id, authData.MessageIndex, matched)
+		holders = append(holders, signerAddress)
+		if na.config.GetSigners() != SignersAll {
+			break
+		}
+	}
+
+	if len(holders) == 0 {
+		return iface.NotAuthenticated()
+	}
+
+	// The holders are recorded by Track, the state kept for them, e.g. their revocation
+	// or uses, is only read once the fee payer paid for the tx
+	return iface.Authenticated()
+}
+
+// authenticateSignature verifies the signature at index i with the public key it was
+// signed with and returns the address of that key, which is the signer checked for
+// the configured denoms
+func (na NFTAuthenticator) authenticateSignature(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	msg sdk.Msg,
+	signatureData authenticator.SignatureData,
+	i int,
+) (sdk.AccAddress, iface.AuthenticationResult) {
+	sigPubKey := signatureData.Signatures[i].PubKey
+	if reason := validatePubKey(sigPubKey); reason != "" {
+		// A holder signing with a key type that can't be verified is named in the event,
+		// without a key there is no signer to check
+		if reason == ReasonUnsupportedKeyType {
+			signerAddress := sdk.AccAddress(sigPubKey.Address())
+			if matched, refused := na.matchRequirements(ctx, account, signerAddress); len(matched) > 0 || refused != nil {
+				return nil, refuse(ctx, account, signerAddress, reason)
+			}
+		}
+		return nil, refuse(ctx, account, nil, reason)
+	}
 
 	// Set the public key to the signature public key
-	na.sva.PubKey = sigPubKey
+	sva := na.sva
+	sva.PubKey = sigPubKey
 
 	// Get the signer address
 	signerAddress := sdk.AccAddress(sigPubKey.Address())
 
-	// Authenticate the signature
-	authenticationResult := na.sva.Authenticate(ctx, signerAddress, msg, authenticationData)
-	if !authenticationResult.IsAuthenticated() {
-		return authenticationResult
+	// Authenticate only this signature, every signature in the tx is verified with
+	// the public key of the account it is for
+	return signerAddress, sva.Authenticate(ctx, signatureData.Signers[i], msg, authenticator.SignatureData{
+		Signers:    []sdk.AccAddress{signatureData.Signers[i]},
+		Signatures: []signing.SignatureV2{signatureData.Signatures[i]},
+		Tx:         signatureData.Tx,
+		Simulate:   signatureData.Simulate,
+	})
+}
+
+// validatePubKey returns the reason a public key can't be used to verify a
+// signature, or an empty reason when the key is supported. Threshold multisig
+// keys are supported so a committee can hold the token as a unit
+func validatePubKey(pubKey cryptotypes.PubKey) string {
+	switch pubKey := pubKey.(type) {
+	case nil:
+		return ReasonMissingPubKey
+	case *secp256k1.PubKey:
+		if pubKey == nil {
+			return ReasonMissingPubKey
+		}
+	case *secp256r1.PubKey:
+		if pubKey == nil {
+			return ReasonMissingPubKey
+		}
+	case *multisig.LegacyAminoPubKey:
+		// The holder is the multisig address, every key of the multisig must be supported
+		if pubKey == nil || len(pubKey.GetPubKeys()) == 0 {
+			return ReasonMissingPubKey
+		}
+		for _, subKey := range pubKey.GetPubKeys() {
+			if reason := validatePubKey(subKey); reason != "" {
+				return reason
+			}
+		}
+	default:
+		return ReasonUnsupportedKeyType
+	}
+	return ""
+}
+
+// refuse records the reason the signer isn't authenticated, NotAuthenticated
+// doesn't carry a reason so it is emitted in a nft_authentication_refused event
+func refuse(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	signer sdk.AccAddress,
+	reason string,
+	attributes ...sdk.Attribute,
+) iface.AuthenticationResult {
+	emitAuthenticationRefused(ctx, account, signer, reason, attributes...)
+	return iface.NotAuthenticated()
+}
+
+// refuseHolder returns why a holder is refused by the config, or nil. Every holder is
+// refused outside of the validity window and for the messages the config doesn't let
+// holders sign. The state kept for the holder, e.g. whether they are revoked, isn't read
+// here so the fee payer is authenticated within its gas limit, it is checked by Track
+// and ConfirmExecution
+func (na NFTAuthenticator) refuseHolder(ctx sdk.Context, msg sdk.Msg) *RefusedError {
+	notBefore, notAfter, _ := na.config.ValidityWindow()
+	switch {
+	case !notBefore.IsZero() && ctx.BlockTime().Before(notBefore):
+		return &RefusedError{Reason: ReasonNotYetValid, Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyNotBefore, na.config.NotBefore)}}
+	case !notAfter.IsZero() && ctx.BlockTime().After(notAfter):
+		return &RefusedError{Reason: ReasonExpired, Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyNotAfter, na.config.NotAfter)}}
 	}
 
-	// Get the balances for the account of the signer
-	balance := na.bankKeeper.GetBalance(ctx, signerAddress, na.denom)
+	// Holders can't take over the account unless the owner explicitly allowed it, this
+	// applies even when the message is in the allowed messages
+	if na.config.IsAdminMessage(msg) && (!na.config.AllowAdminMessages || isPrincipalOnlyMessage(msg)) {
+		return &RefusedError{Reason: ReasonAdminMessageDenied, Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyMsgType, sdk.MsgTypeURL(msg))}}
+	}
 
-	// If account doen't contain the NFT return
-	if !balance.Amount.Equal(osmomath.NewInt(1)) {
-		return iface.NotAuthenticated()
+	// Holders can only sign the allowed messages, the owner of the account authenticates
+	// any other message with their own authenticators
+	if !na.config.IsMessageAllowed(msg) {
+		return &RefusedError{Reason: ReasonMessageNotAllowed, Attributes: []sdk.Attribute{sdk.NewAttribute(AttributeKeyMsgType, sdk.MsgTypeURL(msg))}}
+	}
+	return nil
+}
+
+// matchRequirements returns the denoms that satisfy the config for the signer,
+// if the signer doesn't satisfy the config no denoms are returned along with the
+// first refusal of a checker for a token the signer holds
+func (na NFTAuthenticator) matchRequirements(ctx sdk.Context, account, signer sdk.AccAddress) ([]string, *RefusedError) {
+	requirements := na.config.GetRequirements()
+
+	var refusal *RefusedError
+	switch na.config.GetMatch() {
+	case MatchAnyOf:
+		// The first requirement the signer holds is enough
+		for _, requirement := range requirements {
+			denom, ok, refused := na.holdsRequirement(ctx, account, signer, requirement)
+			if ok {
+				return []string{denom}, nil
+			}
+			if refusal == nil {
+				refusal = refused
+			}
+		}
+	case MatchAllOf:
+		// Every requirement has to be held, stop at the first one that isn't
+		matched := make([]string, 0, len(requirements))
+		for _, requirement := range requirements {
+			denom, ok, refused := na.holdsRequirement(ctx, account, signer, requirement)
+			if !ok {
+				return nil, refused
+			}
+			matched = append(matched, denom)
+		}
+		return matched, nil
+	case MatchWeighted:
+		// Requirements are checked in the order of the config until the threshold is reached
+		var score uint64
+		var matched []string
+		for _, requirement := range requirements {
+			denom, ok, refused := na.holdsRequirement(ctx, account, signer, requirement)
+			if !ok {
+				if refusal == nil {
+					refusal = refused
+				}
+				continue
+			}
+			matched = append(matched, denom)
+			score += requirement.Weight
+			if score >= na.config.Threshold {
+				return matched, nil
+			}
+		}
+	}
+
+	return nil, refusal
+}
+
+// holdsRequirement asks the checker selected by the requirement whether the signer
+// holds a token satisfying it and returns the key of the requirement. A RefusedError
+// of the checker is returned, other errors only refuse the signer with an event since
+// they don't tell whether the signer holds the token
+func (na NFTAuthenticator) holdsRequirement(ctx sdk.Context, account, signer sdk.AccAddress, requirement *Requirement) (string, bool, *RefusedError) {
+	checker, ok := na.checkers[requirement.GetChecker()]
+	if !ok {
+		return requirement.Key(), false, nil
 	}
 
-	// Successful authentication for the NFT holder
-	return authenticationResult
+	owns, err := checker.Owns(ctx, signer, na.config, *requirement)
+	if err != nil {
+		var refused *RefusedError
+		switch {
+		case errors.As(err, &refused) && !refused.HoldingUnknown:
+			return requirement.Key(), false, refused
+		case refused != nil:
+			emitAuthenticationRefused(ctx, account, signer, refused.Reason, refused.Attributes...)
+		default:
+			emitAuthenticationRefused(ctx, account, signer, ReasonOwnershipCheckFailed,
+				sdk.NewAttribute(AttributeKeyDenom, requirement.Key()),
+				sdk.NewAttribute(AttributeKeyError, err.Error()),
+			)
+		}
+		return requirement.Key(), false, nil
+	}
+	return requirement.Key(), owns, nil
+}
+
+// confirmHolders fails when a holder recorded for a message isn't one of its signers,
+// was revoked by the principal or no longer satisfies the config once the messages
+// executed, so the token can't be used to sign a tx handing it to someone else, the
+// principal included. The post handler confirms every message after the whole tx
+// executed, so the ownership is compared at the end of the tx
+func (na NFTAuthenticator) confirmHolders(ctx sdk.Context, account sdk.AccAddress, store prefix.Store, authData NFTAuthData, holders []string) error {
+	revoked := getRevokedHolders(store)
+	for _, holder := range holders {
+		address, err := sdk.AccAddressFromBech32(holder)
+		if err != nil {
+			return err
+		}
+
+		// Only a holder whose key signed the message was authenticated for it
+		var pubKey cryptotypes.PubKey
+		for _, signature := range authData.Signatures {
+			if signature.PubKey != nil && address.Equals(sdk.AccAddress(signature.PubKey.Address())) {
+				pubKey = signature.PubKey
+				break
+			}
+		}
+		if pubKey == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "holder %s didn't sign message %d", holder, authData.MessageIndex)
+		}
+
+		// The size of the revocation list is bounded by MaxRevokedHolders
+		if revoked.isRevoked(address, pubKey) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s: holder %s was revoked by the principal", ReasonHolderRevoked, holder)
+		}
+		if matched, _ := na.matchRequirements(ctx, account, address); len(matched) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "holder %s no longer holds the gating tokens after the tx", holder)
+		}
+	}
+	return nil
 }
 
 // Track is used for authenticators to track any information they may need regardless of how the transaction is
 // authenticated. For instance, if a message is authenticated via authz, ICA, or similar, those entry points should
 // call authenticator.Track(...) so that the authenticator can know that the account has executed a specific message.
+// Every message tracked for the account while holders are authenticated counts towards their uses, including the
+// messages executed through authz or ICA, a holder that used up the quota or is rate limited fails the tx
 func (na NFTAuthenticator) Track(ctx sdk.Context, account sdk.AccAddress, msg sdk.Msg) error {
-	// Track any necessary information (if applicable)
+	// Nothing is pending when no holder authenticated a tx for the account
+	pendingStore := na.getPendingStore(ctx, account)
+	pending, ok := na.trackExecution(ctx, pendingStore, account)
+	if !ok {
+		return nil
+	}
+
+	pending.Messages++
+	store := na.getStore(ctx, account)
+	if err := na.checkQuota(store, pending); err != nil {
+		return err
+	}
+	if err := na.checkRateLimit(ctx, store, pending); err != nil {
+		return err
+	}
+	setState(pendingStore, pendingExecutionKey, pending)
 	return nil
 }
 
 // OnAuthenticatorAdded is called when an authenticator is added to an account. If the data is not properly formatted
 // or the authenticator is not compatible with the account, an error should be returned.
 func (na NFTAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, data []byte) error {
-	// Handle actions when an authenticator is added to an account (if needed)
+	// Reject malformed configs here, otherwise the authenticator would fail to
+	// initialize every time it is loaded for the account
+	config, err := ParseConfig(data)
+	if err != nil {
+		return err
+	}
+
+	// The state of an authenticator is kept under the hash of its config, two
+	// authenticators with the same config would share their uses and revocations
+	if na.authenticatorKeeper == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the NFTAuthenticator requires the authenticator keeper to be set")
+	}
+	authenticators, err := na.authenticatorKeeper.GetAuthenticatorDataForAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	for _, existing := range authenticators {
+		if existing.Type == NFTAuthenticatorType && authenticatorID(existing.Data) == authenticatorID(data) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already has NFTAuthenticator %d with the same config", account, existing.Id)
+		}
+	}
+
+	for _, requirement := range config.GetRequirements() {
+		checker, ok := na.checkers[requirement.GetChecker()]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ownership checker %s is not registered", requirement.GetChecker())
+		}
+		if err := checker.ValidateRequirement(ctx, account, config, *requirement); err != nil {
+			return err
+		}
+	}
+
+	// The keeper discards the writes made here, the denoms of a config with a holding
+	// period are gated by ConfirmExecution once the tx adding it executed
 	return nil
 }
 
@@ -136,19 +562,66 @@ func (na NFTAuthenticator) OnAuthenticatorAdded(ctx sdk.Context, account sdk.Acc
 // by returning an error.
 // Removal prevention should be used sparingly and only when absolutely necessary.
 func (na NFTAuthenticator) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, data []byte) error {
-	// Handle actions when an authenticator is removed from an account (if needed)
+	// The state kept for the holders is deleted, so the uses, revocations and pause of
+	// the authenticator don't come back when the same config is added again
+	kvStore := ctx.KVStore(na.stateStoreKey)
+	id := authenticatorID(data)
+
+	// The denoms are only ungated when ConfirmExecution gated them for the authenticator
+	if config, err := ParseConfig(data); err == nil && getAccountStore(kvStore, account, id).Has(gatedKey) {
+		gateHoldings(kvStore, ctx.BlockHeight(), config, -1)
+	}
+	deleteState(kvStore, account, id)
 	return nil
 }
 
 // ConfirmExecution is employed in the post-handler function to enforce transaction rules,
 // such as spending and transaction limits. It accesses the account's owned state to store
-// and verify these values.
+// and verify these values. The holders that authenticated the message must have signed it,
+// not be revoked and still hold the gating tokens. The holders that authenticated the tx
+// are charged for what the account spent and the messages executed, the execution is
+// blocked when a holder exceeds the spend limit, the max uses or the rate limit
 func (na NFTAuthenticator) ConfirmExecution(
 	ctx sdk.Context,
 	account sdk.AccAddress,
 	msg sdk.Msg,
 	authenticationData iface.AuthenticatorData,
 ) iface.ConfirmationResult {
-	// Confirm the execution of the transaction (if needed)
+	store := na.getStore(ctx, account)
+	na.gateDenoms(ctx, store)
+
+	// Without the data of the tx, e.g. for the messages executed through authz, there
+	// are no holders recorded for the message
+	pendingStore := na.getPendingStore(ctx, account)
+	if authData, ok := authenticationData.(NFTAuthData); ok {
+		var holders []string
+		key := getPendingMessageKey(authData.MessageIndex)
+		if getState(pendingStore, key, &holders) {
+			pendingStore.Delete(key)
+			if err := na.confirmHolders(ctx, account, store, authData, holders); err != nil {
+				return iface.Block(err)
+			}
+		}
+	}
+
+	// Nothing is pending when the tx was authenticated by another authenticator or
+	// when an earlier message of the tx already confirmed the execution
+	var pending pendingExecution
+	if !getState(pendingStore, pendingExecutionKey, &pending) {
+		return iface.Confirm()
+	}
+	pendingStore.Delete(pendingExecutionKey)
+
+	if err := na.chargeSpendLimit(ctx, store, account, pending); err != nil {
+		return iface.Block(err)
+	}
+	if err := na.checkQuota(store, pending); err != nil {
+		return iface.Block(err)
+	}
+	if err := na.checkRateLimit(ctx, store, pending); err != nil {
+		return iface.Block(err)
+	}
+	na.chargeUses(store, pending)
+	na.chargeRateLimit(ctx, store, pending)
 	return iface.Confirm()
 }
@@ -101,8 +101,12 @@ func (s *AuthenticatorSuite) TestRoundTripAliceBobAndChris() {
 	//
 	nftAuth := NewNFTAuthenticator(
 		s.app.BankKeeper,
+		s.app.GetKey(banktypes.StoreKey),
+		*s.app.TokenFactoryKeeper,
+		s.app.GetKey(authenticatortypes.AuthenticatorStoreKey),
+		s.app.GetKey(authenticatortypes.ManagerStoreKey),
 		sva,
-	)
+	).WithAuthenticatorKeeper(s.app.AuthenticatorKeeper)
 
 	//
 	// Register both Authenticators with the AuthenticatorManager
@@ -132,8 +136,11 @@ func (s *AuthenticatorSuite) TestRoundTripAliceBobAndChris() {
 		Data:   initData,
 	}
 
+	//
+	// Error adding the NFTAuthenticator as the NFT doesn't exist in the tokenfactory yet
+	//
 	_, err = s.chainA.SendMsgsFromPrivKeys(pks{Alice}, msgAddNFTAuthenticator)
-	s.Require().NoError(err, "Failed to add authenticator")
+	s.Require().ErrorContains(err, "does not exist")
 
 	//
 	// Create the NFT in the tokenfactory
@@ -145,6 +152,12 @@ func (s *AuthenticatorSuite) TestRoundTripAliceBobAndChris() {
 	_, err = s.chainA.SendMsgsFromPrivKeys(pks{Alice}, createNFTMsg)
 	s.Require().NoError(err)
 
+	//
+	// Alice is the admin of the NFT so the NFTAuthenticator can now be added
+	//
+	_, err = s.chainA.SendMsgsFromPrivKeys(pks{Alice}, msgAddNFTAuthenticator)
+	s.Require().NoError(err, "Failed to add authenticator")
+
 	//
 	// Create the Mint NFT message
 	//
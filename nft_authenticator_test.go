@@ -2,26 +2,40 @@ package nft
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	"github.com/osmosis-labs/osmosis/v19/app"
 	"github.com/osmosis-labs/osmosis/v19/app/params"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/osmosis-labs/osmosis/v19/x/authenticator/authenticator"
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/iface"
+	authenticatortypes "github.com/osmosis-labs/osmosis/v19/x/authenticator/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v19/x/poolmanager/types"
+	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/osmosis-labs/osmosis/v19/x/tokenfactory/types"
 )
 
 // NFTAuthenticatorTest is the test suite struct for testing NFT authenticator functionality.
@@ -80,8 +94,12 @@ func (s *NFTAuthenticatorTest) SetupTest() {
 	// Create a the NFT authenticator with the bank keeper and tokenfactory keeper.
 	s.NFT = NewNFTAuthenticator(
 		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.GetKey(banktypes.StoreKey),
+		*s.OsmosisApp.TokenFactoryKeeper,
+		s.OsmosisApp.GetKey(authenticatortypes.AuthenticatorStoreKey),
+		s.OsmosisApp.GetKey(authenticatortypes.ManagerStoreKey),
 		sva,
-	)
+	).WithAuthenticatorKeeper(s.OsmosisApp.AuthenticatorKeeper)
 }
 
 // TestNFTAuthentication performs a series of tests to validate the NFT authentication flow.
@@ -234,6 +252,1671 @@ func (s *NFTAuthenticatorTest) TestNFTAuthentication() {
 	s.Require().False(authentication.IsAuthenticated())
 }
 
+// TestOnAuthenticatorAdded ensures that an NFTAuthenticator can only be added by the
+// tokenfactory admin of an existing denom.
+func (s *NFTAuthenticatorTest) TestOnAuthenticatorAdded() {
+	denom, err := s.OsmosisApp.TokenFactoryKeeper.CreateDenom(
+		s.Ctx,
+		s.TestAccAddress[0].String(),
+		"nft",
+	)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name    string
+		account sdk.AccAddress
+		data    []byte
+		err     string
+	}{
+		{
+			name:    "admin of the denom",
+			account: s.TestAccAddress[0],
+			data:    []byte(denom),
+		},
+		{
+			name:    "malformed config",
+			account: s.TestAccAddress[0],
+			data:    []byte(`{"version":1`),
+			err:     "failed to unmarshal json config",
+		},
+		{
+			name:    "not a tokenfactory denom",
+			account: s.TestAccAddress[0],
+			data:    []byte("uosmo"),
+			err:     "is not a tokenfactory denom",
+		},
+		{
+			name:    "denom does not exist",
+			account: s.TestAccAddress[0],
+			data:    []byte(denom + "2"),
+			err:     "does not exist",
+		},
+		{
+			name:    "account is not the admin",
+			account: s.TestAccAddress[1],
+			data:    []byte(denom),
+			err:     "is not the admin",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.NFT.OnAuthenticatorAdded(s.Ctx, tc.account, tc.data)
+			if tc.err != "" {
+				s.Require().ErrorContains(err, tc.err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// TestStrictSupply ensures that in strict supply mode a holder is refused once the
+// total supply of the denom is more than one.
+func (s *NFTAuthenticatorTest) TestStrictSupply() {
+	denom := s.CreateNFT("strict", s.TestAccAddress[1])
+	config := []byte(`{"version":1,"denom":"` + denom + `","strict_supply":true}`)
+
+	s.Require().NoError(s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config))
+
+	nftAuth, err := s.NFT.Initialize(config)
+	s.Require().NoError(err)
+
+	//
+	// Account 1 holds the only token in existence
+	//
+	authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+	s.Require().True(authentication.IsAuthenticated())
+
+	//
+	// The admin mints a second token for Account 2, now both holders are rejected
+	//
+	s.Mint(denom, 1, s.TestAccAddress[2])
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication = s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+	s.Require().False(authentication.IsAuthenticated())
+	s.Require().True(authentication.IsAuthenticationFailed())
+
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonSupplyNotOne)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeySupply), Value: []byte("2")})
+
+	//
+	// The authenticator can no longer be added for the denom either
+	//
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config)
+	s.Require().ErrorContains(err, "has a supply of 2")
+}
+
+// TestFungibleTokenGating ensures a minimum balance of a fungible token can be used
+// to gate the account instead of a single NFT.
+func (s *NFTAuthenticatorTest) TestFungibleTokenGating() {
+	denom := s.CreateNFT("gov", s.TestAccAddress[1])
+	s.Mint(denom, 999, s.TestAccAddress[1])
+	s.Mint(denom, 1000, s.TestAccAddress[2])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","min_amount":"1000"}`))
+	s.Require().NoError(err)
+
+	//
+	// Account 1 and Account 2 both hold the minimum of 1000 tokens
+	//
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Account 1 sends a token away and falls below the minimum
+	//
+	err = s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[1], s.TestAccAddress[2], sdk.Coins{sdk.NewInt64Coin(denom, 1)})
+	s.Require().NoError(err)
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+}
+
+// TestAnyOfDenoms ensures that holding any one of several configured denoms is enough
+// to authenticate, and that the denom that matched is recorded in an event.
+func (s *NFTAuthenticatorTest) TestAnyOfDenoms() {
+	ops := s.CreateNFT("ops", s.TestAccAddress[0])
+	oncall := s.CreateNFT("oncall", s.TestAccAddress[1])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"match":"any_of","requirements":[{"denom":"` + ops + `"},{"denom":"` + oncall + `"}]}`))
+	s.Require().NoError(err)
+
+	//
+	// Account 1 only holds the oncall badge
+	//
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(EventTypeAuthenticated, events[0].Type)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyDenom), Value: []byte(oncall)})
+
+	//
+	// Account 2 doesn't hold any of the badges
+	//
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+}
+
+// TestAllOfDenoms ensures that every configured denom must be held by the signer.
+func (s *NFTAuthenticatorTest) TestAllOfDenoms() {
+	member := s.CreateNFT("member", s.TestAccAddress[1])
+	treasury := s.CreateNFT("treasury", s.TestAccAddress[1])
+	s.Mint(member, 1, s.TestAccAddress[2])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"match":"all_of","requirements":[{"denom":"` + member + `"},{"denom":"` + treasury + `"}]}`))
+	s.Require().NoError(err)
+
+	//
+	// Account 1 holds both badges
+	//
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyDenom), Value: []byte(member)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyDenom), Value: []byte(treasury)})
+
+	//
+	// Account 2 only holds the member badge
+	//
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+}
+
+// TestWeightedDenoms ensures the summed weights of the denoms held by the signer must
+// reach the threshold, i.e. one gold badge or two silver badges.
+func (s *NFTAuthenticatorTest) TestWeightedDenoms() {
+	gold := s.CreateNFT("gold", s.TestAccAddress[1])
+	silverA := s.CreateNFT("silver-a", s.TestAccAddress[2])
+	silverB := s.CreateNFT("silver-b", s.TestAccAddress[0])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"match":"weighted","threshold":2,"requirements":[` +
+		`{"denom":"` + gold + `","weight":2},` +
+		`{"denom":"` + silverA + `","weight":1},` +
+		`{"denom":"` + silverB + `","weight":1}]}`))
+	s.Require().NoError(err)
+
+	//
+	// Account 1 holds the gold badge
+	//
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// Account 2 only holds a single silver badge, gas is consumed for every denom checked
+	//
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+	s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed()-gasBefore, 3*DenomCheckGas)
+
+	//
+	// Account 2 receives the second silver badge
+	//
+	err = s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[2], sdk.Coins{sdk.NewInt64Coin(silverB, 1)})
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+}
+
+// TestCollection ensures that holding any denom under the collection prefix is enough
+// to authenticate, including denoms created after the authenticator was added.
+func (s *NFTAuthenticatorTest) TestCollection() {
+	s.CreateNFT("member-1", s.TestAccAddress[1])
+	s.CreateNFT("guest-1", s.TestAccAddress[2])
+
+	config := []byte(`{"version":1,"requirements":[{"collection":{"creator":"` + s.TestAccAddress[0].String() + `","subdenom_prefix":"member-"}}]}`)
+
+	//
+	// Only the creator of the collection can add the authenticator
+	//
+	s.Require().NoError(s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config))
+	err := s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[1], config)
+	s.Require().ErrorContains(err, "is not the creator of collection")
+
+	nftAuth, err := s.NFT.Initialize(config)
+	s.Require().NoError(err)
+
+	//
+	// Account 1 holds a member badge, Account 2 only holds a guest badge
+	//
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Onboarding Account 2 only requires minting a new member badge
+	//
+	s.CreateNFT("member-2", s.TestAccAddress[2])
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Denoms sorted before the collection aren't read, so dust sent to a holder
+	// doesn't make the authentication more expensive
+	//
+	gasUsed := func() sdk.Gas {
+		s.Ctx = s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+		return s.Ctx.GasMeter().GasConsumed()
+	}
+	before := gasUsed()
+	for i := 0; i < 10; i++ {
+		s.Mint(s.CreateDenom(fmt.Sprintf("a-dust-%d", i)), 1, s.TestAccAddress[2])
+	}
+	s.Require().Equal(before, gasUsed())
+
+	//
+	// A denom whose admin the creator handed over is no longer part of the collection,
+	// the new admin could mint it to anyone
+	//
+	member := "factory/" + s.TestAccAddress[0].String() + "/member-2"
+	_, err = tokenfactorykeeper.NewMsgServerImpl(*s.OsmosisApp.TokenFactoryKeeper).ChangeAdmin(sdk.WrapSDKContext(s.Ctx), &tokenfactorytypes.MsgChangeAdmin{
+		Sender:   s.TestAccAddress[0].String(),
+		Denom:    member,
+		NewAdmin: s.TestAccAddress[2].String(),
+	})
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2])
+	s.Require().True(authentication.IsAuthenticationFailed())
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonDenomAdminChanged)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyDenom), Value: []byte(member)})
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config)
+	s.Require().ErrorContains(err, "is not the admin of denom "+member)
+}
+
+// TestNFTModule checks ownership of x/nft NFTs, both of a single NFT and of any NFT in a class
+func (s *NFTAuthenticatorTest) TestNFTModule() {
+	nftKeeper := mockNFTKeeper{
+		"members": {"alice": s.TestAccAddress[1], "bob": s.TestAccAddress[1]},
+	}
+	nftModuleAuth := NewNFTAuthenticator(
+		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.GetKey(banktypes.StoreKey),
+		*s.OsmosisApp.TokenFactoryKeeper,
+		s.NFT.storeKey,
+		s.NFT.stateStoreKey,
+		s.NFT.sva,
+		NewNFTModuleChecker(nftKeeper),
+	).WithAuthenticatorKeeper(s.OsmosisApp.AuthenticatorKeeper)
+
+	byID := []byte(`{"version":1,"requirements":[{"nft_class_id":"members","nft_id":"alice"}]}`)
+	byClass := []byte(`{"version":1,"requirements":[{"nft_class_id":"members"}]}`)
+
+	//
+	// The class and NFT must exist and the chain must have the x/nft module
+	//
+	s.Require().NoError(nftModuleAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], byID))
+	err := nftModuleAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"nft_class_id":"members","nft_id":"carol"}]}`))
+	s.Require().ErrorContains(err, "nft carol of class members does not exist")
+	err = nftModuleAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"nft_class_id":"guests"}]}`))
+	s.Require().ErrorContains(err, "nft class guests does not exist")
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], byID)
+	s.Require().ErrorContains(err, "ownership checker nft is not registered")
+
+	//
+	// Only the owner of the NFT authenticates
+	//
+	nftAuth, err := nftModuleAuth.Initialize(byID)
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Holding more than one NFT of the class is fine when gating on the class
+	//
+	nftAuth, err = nftModuleAuth.Initialize(byClass)
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	nftKeeper["members"]["alice"] = s.TestAccAddress[2]
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Without the x/nft module nobody authenticates
+	//
+	nftAuth, err = s.NFT.Initialize(byClass)
+	s.Require().NoError(err)
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+}
+
+// CreateNFT creates a tokenfactory denom administered by Account 0 and mints a
+// single token of it to the receiver
+func (s *NFTAuthenticatorTest) CreateNFT(subdenom string, receiver sdk.AccAddress) string {
+	denom := s.CreateDenom(subdenom)
+	s.Mint(denom, 1, receiver)
+	return denom
+}
+
+// CreateDenom creates a tokenfactory denom administered by Account 0
+func (s *NFTAuthenticatorTest) CreateDenom(subdenom string) string {
+	// Creating a denom consumes a lot of gas, so it is done outside of the test gas meter
+	denom, err := s.OsmosisApp.TokenFactoryKeeper.CreateDenom(
+		s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()),
+		s.TestAccAddress[0].String(),
+		subdenom,
+	)
+	s.Require().NoError(err)
+	return denom
+}
+
+// Mint mints the amount of the denom and sends it to the receiver
+func (s *NFTAuthenticatorTest) Mint(denom string, amount int64, receiver sdk.AccAddress) {
+	coins := sdk.Coins{sdk.NewInt64Coin(denom, amount)}
+	err := s.OsmosisApp.BankKeeper.MintCoins(s.Ctx, "tokenfactory", coins)
+	s.Require().NoError(err)
+
+	err = s.OsmosisApp.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, "tokenfactory", receiver, coins)
+	s.Require().NoError(err)
+}
+
+// AuthenticateFrom generates a bank send on behalf of Account 0 signed by the signer and
+// authenticates it using the initialized NFT authenticator
+func (s *NFTAuthenticatorTest) AuthenticateFrom(
+	nftAuth iface.Authenticator,
+	signer cryptotypes.PrivKey,
+) iface.AuthenticationResult {
+	msg := &banktypes.MsgSend{
+		FromAddress: s.TestAccAddress[0].String(),
+		ToAddress:   s.TestAccAddress[1].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+	}
+	return s.AuthenticateMsgFrom(nftAuth, msg, signer)
+}
+
+// ExecuteFrom authenticates a tx for account 0 signed by the holder, tracks the messages
+// of the tx and confirms the execution like the ante and post handlers do. Every tx
+// has its own events, Track records the holders authenticated in them, and its state
+// is only written when it succeeds
+func (s *NFTAuthenticatorTest) ExecuteFrom(nftAuth iface.Authenticator, holder cryptotypes.PrivKey, messages int) error {
+	msg := &banktypes.MsgSend{
+		FromAddress: s.TestAccAddress[0].String(),
+		ToAddress:   s.TestAccAddress[1].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+	}
+	authData := s.AuthenticationDataFrom(nftAuth, msg, holder)
+
+	ctx, write := s.Ctx.WithEventManager(sdk.NewEventManager()).CacheContext()
+	s.Require().True(nftAuth.Authenticate(ctx, s.TestAccAddress[0], msg, authData).IsAuthenticated())
+	for i := 0; i < messages; i++ {
+		if err := nftAuth.Track(ctx, s.TestAccAddress[0], msg); err != nil {
+			return err
+		}
+	}
+	if err := nftAuth.ConfirmExecution(ctx, s.TestAccAddress[0], msg, authData).Error(); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// AuthenticateMsgFrom authenticates a message for account 0 signed by the signer
+func (s *NFTAuthenticatorTest) AuthenticateMsgFrom(
+	nftAuth iface.Authenticator,
+	msg sdk.Msg,
+	signer cryptotypes.PrivKey,
+) iface.AuthenticationResult {
+	return nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, s.AuthenticationDataFrom(nftAuth, msg, signer))
+}
+
+// AuthenticationDataFrom returns the authentication data of a tx for account 0 with
+// the message signed by the signer
+func (s *NFTAuthenticatorTest) AuthenticationDataFrom(
+	nftAuth iface.Authenticator,
+	msg sdk.Msg,
+	signer cryptotypes.PrivKey,
+) iface.AuthenticatorData {
+	tx, err := GenTx(
+		s.EncodingConfig.TxConfig,
+		[]sdk.Msg{msg},
+		sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+		300000,
+		"",
+		[]uint64{0},
+		[]uint64{0},
+		[]cryptotypes.PrivKey{s.TestPrivKeys[0]},
+		[]cryptotypes.PrivKey{signer},
+	)
+	s.Require().NoError(err)
+
+	authData, err := nftAuth.GetAuthenticationData(s.Ctx, tx, 0, false)
+	s.Require().NoError(err)
+	return authData
+}
+
+// TestCW721 checks ownership of CW721 tokens and that failing contracts don't fail the transaction
+func (s *NFTAuthenticatorTest) TestCW721() {
+	contract := sdk.AccAddress([]byte("cw721_contract______")).String()
+	failing := sdk.AccAddress([]byte("cw721_failing_______")).String()
+	expensive := sdk.AccAddress([]byte("cw721_expensive_____")).String()
+
+	wasmKeeper := mockWasmKeeper{
+		contract:  {owners: map[string]string{"1": s.TestAccAddress[1].String()}},
+		failing:   {err: true},
+		expensive: {gas: CW721QueryGasLimit + 1},
+	}
+	cw721Auth := NewNFTAuthenticator(
+		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.GetKey(banktypes.StoreKey),
+		*s.OsmosisApp.TokenFactoryKeeper,
+		s.NFT.storeKey,
+		s.NFT.stateStoreKey,
+		s.NFT.sva,
+		NewCW721Checker(wasmKeeper),
+	).WithAuthenticatorKeeper(s.OsmosisApp.AuthenticatorKeeper)
+
+	//
+	// The contract must exist and the chain must have CosmWasm
+	//
+	byToken := []byte(`{"version":1,"requirements":[{"cw721":{"contract":"` + contract + `","token_id":"1"}}]}`)
+	s.Require().NoError(cw721Auth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], byToken))
+	missing := sdk.AccAddress([]byte("cw721_missing_______")).String()
+	err := cw721Auth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"cw721":{"contract":"`+missing+`"}}]}`))
+	s.Require().ErrorContains(err, "does not exist")
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], byToken)
+	s.Require().ErrorContains(err, "ownership checker cw721 is not registered")
+
+	//
+	// Only the owner of the token authenticates, with or without a token ID
+	//
+	for _, config := range [][]byte{byToken, []byte(`{"version":1,"requirements":[{"cw721":{"contract":"` + contract + `"}}]}`)} {
+		nftAuth, err := cw721Auth.Initialize(config)
+		s.Require().NoError(err)
+		s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+		s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+	}
+
+	//
+	// Contract errors and running out of gas refuse the signer without panicking,
+	// the gas used by the query is still charged
+	//
+	for _, failingContract := range []string{failing, expensive} {
+		nftAuth, err := cw721Auth.Initialize([]byte(`{"version":1,"requirements":[{"cw721":{"contract":"` + failingContract + `","token_id":"1"}}]}`))
+		s.Require().NoError(err)
+
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+		events := s.Ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+		s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonCW721QueryFailed)})
+	}
+}
+
+// TestIBCVouchers checks that holders of an IBC voucher of the gating denom are authenticated
+func (s *NFTAuthenticatorTest) TestIBCVouchers() {
+	ibcAuth := NewNFTAuthenticator(
+		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.GetKey(banktypes.StoreKey),
+		*s.OsmosisApp.TokenFactoryKeeper,
+		s.NFT.storeKey,
+		s.NFT.stateStoreKey,
+		s.NFT.sva,
+		NewIBCChecker(NewBankChecker(s.OsmosisApp.BankKeeper, s.OsmosisApp.GetKey(banktypes.StoreKey), *s.OsmosisApp.TokenFactoryKeeper), s.OsmosisApp.TransferKeeper),
+	).WithAuthenticatorKeeper(s.OsmosisApp.AuthenticatorKeeper)
+
+	//
+	// Account 1 holds a voucher of a badge created on another chain
+	//
+	foreign := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "factory/juno1badgecreator/badge"}
+	s.OsmosisApp.TransferKeeper.SetDenomTrace(s.Ctx, foreign)
+	s.Mint(foreign.IBCDenom(), 1, s.TestAccAddress[1])
+
+	config := []byte(`{"version":1,"requirements":[{"denom":"` + foreign.BaseDenom + `","ibc_paths":["transfer/channel-0"]}]}`)
+	s.Require().NoError(ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config))
+	err := ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"denom":"`+foreign.BaseDenom+`","ibc_paths":["transfer/channel-1"]}]}`))
+	s.Require().ErrorContains(err, "ibc denom trace transfer/channel-1/"+foreign.BaseDenom+" does not exist")
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config)
+	s.Require().ErrorContains(err, "ownership checker ibc is not registered")
+
+	//
+	// A base denom of this chain must be a tokenfactory denom that exists
+	//
+	local := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: sdk.DefaultBondDenom}
+	s.OsmosisApp.TransferKeeper.SetDenomTrace(s.Ctx, local)
+	err = ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"denom":"`+sdk.DefaultBondDenom+`","ibc_paths":["transfer/channel-0"]}]}`))
+	s.Require().ErrorContains(err, "denom "+sdk.DefaultBondDenom+" exists on this chain and is not a tokenfactory denom")
+
+	missing := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "factory/" + s.TestAccAddress[0].String() + "/missing"}
+	s.OsmosisApp.TransferKeeper.SetDenomTrace(s.Ctx, missing)
+	err = ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"denom":"`+missing.BaseDenom+`","ibc_paths":["transfer/channel-0"]}]}`))
+	s.Require().ErrorContains(err, "denom "+missing.BaseDenom+" does not exist")
+
+	nftAuth, err := ibcAuth.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// For a badge created on this chain the admin is still checked and only the voucher
+	// of a badge that was bridged back over the allowed path is accepted
+	//
+	native := s.CreateNFT("badge", s.TestAccAddress[1])
+	bridged := transfertypes.DenomTrace{Path: "transfer/channel-1/transfer/channel-2", BaseDenom: native}
+	s.OsmosisApp.TransferKeeper.SetDenomTrace(s.Ctx, bridged)
+
+	config = []byte(`{"version":1,"requirements":[{"denom":"` + native + `","ibc_paths":["` + bridged.Path + `"]}]}`)
+	s.Require().NoError(ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config))
+	err = ibcAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[1], config)
+	s.Require().ErrorContains(err, "is not the admin of denom")
+
+	nftAuth, err = ibcAuth.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	s.Mint(bridged.IBCDenom(), 1, s.TestAccAddress[2])
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+}
+
+// TestMultipleSigners checks that the signature for the account is found in a tx with
+// several signers and that the signers mode decides which signers must be holders
+func (s *NFTAuthenticatorTest) TestMultipleSigners() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+
+	// Account 2 pays the fees and signs first, Account 1 holds the NFT and signs for Account 0
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: s.TestAccAddress[2].String(),
+			ToAddress:   s.TestAccAddress[1].String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 100)},
+		},
+		&banktypes.MsgSend{
+			FromAddress: s.TestAccAddress[0].String(),
+			ToAddress:   s.TestAccAddress[1].String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 100)},
+		},
+	}
+	tx, err := GenTx(
+		s.EncodingConfig.TxConfig,
+		msgs,
+		sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+		300000,
+		"",
+		[]uint64{0, 0},
+		[]uint64{0, 0},
+		[]cryptotypes.PrivKey{s.TestPrivKeys[2], s.TestPrivKeys[0]},
+		[]cryptotypes.PrivKey{s.TestPrivKeys[2], s.TestPrivKeys[1]},
+	)
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name          string
+		signers       SignersMode
+		authenticated bool
+	}{
+		{name: "signer for the account", signers: "", authenticated: true},
+		{name: "explicit signer for the account", signers: SignersOne, authenticated: true},
+		{name: "any signer", signers: SignersAny, authenticated: true},
+		{name: "all signers", signers: SignersAll, authenticated: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			config := []byte(`{"version":1,"denom":"` + denom + `","signers":"` + string(tc.signers) + `"}`)
+			nftAuth, err := s.NFT.Initialize(config)
+			s.Require().NoError(err)
+
+			authData, err := nftAuth.GetAuthenticationData(s.Ctx, tx, -1, false)
+			s.Require().NoError(err)
+
+			authentication := nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msgs[1], authData)
+			s.Require().Equal(tc.authenticated, authentication.IsAuthenticated())
+		})
+	}
+
+	//
+	// Once the fee payer holds a token as well every signer is a holder
+	//
+	s.Mint(denom, 1, s.TestAccAddress[2])
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","signers":"all"}`))
+	s.Require().NoError(err)
+	authData, err := nftAuth.GetAuthenticationData(s.Ctx, tx, -1, false)
+	s.Require().NoError(err)
+	s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msgs[1], authData).IsAuthenticated())
+}
+
+// TestMalformedAuthenticationData checks that every malformed input refuses the
+// signer with a reason instead of panicking inside the ante handler
+func (s *NFTAuthenticatorTest) TestMalformedAuthenticationData() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	nftAuth, err := s.NFT.Initialize([]byte(denom))
+	s.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: s.TestAccAddress[0].String(),
+		ToAddress:   s.TestAccAddress[1].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+	}
+	tx, err := GenTx(
+		s.EncodingConfig.TxConfig,
+		[]sdk.Msg{msg},
+		sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+		300000,
+		"",
+		[]uint64{0},
+		[]uint64{0},
+		[]cryptotypes.PrivKey{s.TestPrivKeys[0]},
+		[]cryptotypes.PrivKey{s.TestPrivKeys[1]},
+	)
+	s.Require().NoError(err)
+
+	// validData returns a fresh copy of the authentication data for the tx
+	validData := func() NFTAuthData {
+		authData, err := nftAuth.GetAuthenticationData(s.Ctx, tx, 0, false)
+		s.Require().NoError(err)
+		return authData.(NFTAuthData)
+	}
+
+	tests := []struct {
+		name     string
+		authData func() iface.AuthenticatorData
+		reason   string
+	}{
+		{
+			name:     "wrong authentication data type",
+			authData: func() iface.AuthenticatorData { return iface.EmptyAuthenticationData{} },
+			reason:   ReasonInvalidAuthenticationData,
+		},
+		{
+			name:     "signature data without the message index",
+			authData: func() iface.AuthenticatorData { return validData().SignatureData },
+			reason:   ReasonInvalidAuthenticationData,
+		},
+		{
+			name:     "no signatures",
+			authData: func() iface.AuthenticatorData { return NFTAuthData{} },
+			reason:   ReasonNoSignatures,
+		},
+		{
+			name: "more signers than signatures",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signers = append(data.Signers, s.TestAccAddress[2])
+				return data
+			},
+			reason: ReasonInvalidAuthenticationData,
+		},
+		{
+			name: "no signature for the account",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signers = []sdk.AccAddress{s.TestAccAddress[2]}
+				return data
+			},
+			reason: ReasonNoSignatures,
+		},
+		{
+			name: "nil pubkey",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signatures[0].PubKey = nil
+				return data
+			},
+			reason: ReasonMissingPubKey,
+		},
+		{
+			name: "typed nil pubkey",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signatures[0].PubKey = (*secp256k1.PubKey)(nil)
+				return data
+			},
+			reason: ReasonMissingPubKey,
+		},
+		{
+			name: "unsupported key type",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signatures[0].PubKey = ed25519.GenPrivKey().PubKey()
+				return data
+			},
+			reason: ReasonUnsupportedKeyType,
+		},
+		{
+			name: "multisig with an unsupported key type",
+			authData: func() iface.AuthenticatorData {
+				data := validData()
+				data.Signatures[0].PubKey = kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{ed25519.GenPrivKey().PubKey()})
+				return data
+			},
+			reason: ReasonUnsupportedKeyType,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			var authentication iface.AuthenticationResult
+			s.Require().NotPanics(func() {
+				authentication = nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, tc.authData())
+			})
+			s.Require().True(authentication.IsAuthenticationFailed())
+
+			events := s.Ctx.EventManager().Events()
+			s.Require().Len(events, 1)
+			s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+			s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(tc.reason)})
+		})
+	}
+
+	//
+	// The unmodified data still authenticates
+	//
+	s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, validData()).IsAuthenticated())
+
+	//
+	// A holder signing with an unsupported key type is refused with the reason
+	//
+	holderKey := ed25519.GenPrivKey().PubKey()
+	s.Mint(denom, 1, sdk.AccAddress(holderKey.Address()))
+	data := validData()
+	data.Signatures[0].PubKey = holderKey
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication := nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, data)
+	s.Require().True(authentication.IsAuthenticationFailed())
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonUnsupportedKeyType)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeySigner), Value: []byte(sdk.AccAddress(holderKey.Address()).String())})
+}
+
+// TestMultisigHolder checks that a threshold multisig holding the token authenticates as a unit
+func (s *NFTAuthenticatorTest) TestMultisigHolder() {
+	committee := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{s.TestPrivKeys[1].PubKey(), s.TestPrivKeys[2].PubKey()})
+	denom := s.CreateNFT("treasury", sdk.AccAddress(committee.Address()))
+
+	nftAuth, err := s.NFT.Initialize([]byte(denom))
+	s.Require().NoError(err)
+
+	//
+	// Both members sign, the multisig address holds the token
+	//
+	s.Require().True(s.AuthenticateFromMultisig(nftAuth, committee, s.TestPrivKeys[1], s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// The threshold isn't reached with a single member
+	//
+	s.Require().False(s.AuthenticateFromMultisig(nftAuth, committee, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// A multisig of the same members with another threshold is another address
+	//
+	other := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{s.TestPrivKeys[1].PubKey(), s.TestPrivKeys[2].PubKey()})
+	s.Require().False(s.AuthenticateFromMultisig(nftAuth, other, s.TestPrivKeys[1]).IsAuthenticated())
+}
+
+// TestAllowedMessages checks that holders can only sign the allowed message types
+func (s *NFTAuthenticatorTest) TestAllowedMessages() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["/cosmos.bank.v1beta1.MsgSend","/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn"]}`))
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// A MsgSend isn't allowed when only swaps are
+	//
+	nftAuth, err = s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["/osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn"]}`))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+	s.Require().False(authentication.IsAuthenticated())
+	s.Require().True(authentication.IsAuthenticationFailed())
+
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonMessageNotAllowed)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyMsgType), Value: []byte("/cosmos.bank.v1beta1.MsgSend")})
+
+	//
+	// A MsgExec is allowed when every message it executes is
+	//
+	swap := &poolmanagertypes.MsgSwapExactAmountIn{Sender: s.TestAccAddress[0].String()}
+	exec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{swap})
+	s.Require().True(s.AuthenticateMsgFrom(nftAuth, &exec, s.TestPrivKeys[1]).IsAuthenticated())
+
+	exec = authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{swap, &banktypes.MsgSend{FromAddress: s.TestAccAddress[0].String()}})
+	s.Require().False(s.AuthenticateMsgFrom(nftAuth, &exec, s.TestPrivKeys[1]).IsAuthenticated())
+}
+
+// TestAdminMessages checks that holders can't take over the account unless the owner allows it
+func (s *NFTAuthenticatorTest) TestAdminMessages() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	owner := s.TestAccAddress[0].String()
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","allowed_messages":["/osmosis.authenticator.MsgAddAuthenticator","/osmosis.tokenfactory.v1beta1.MsgMint"]}`))
+	s.Require().NoError(err)
+
+	addAuthenticator := &authenticatortypes.MsgAddAuthenticator{Sender: owner, Type: "SignatureVerificationAuthenticator", Data: s.TestPrivKeys[1].PubKey().Bytes()}
+	mint := &tokenfactorytypes.MsgMint{Sender: owner, Amount: sdk.NewInt64Coin(denom, 1), MintToAddress: s.TestAccAddress[1].String()}
+
+	// The account can execute messages for itself with a MsgExec, the messages it
+	// executes are checked as well, including those of nested MsgExecs
+	exec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{addAuthenticator})
+	innerExec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{&banktypes.MsgSend{FromAddress: owner}, mint})
+	nestedExec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{&innerExec})
+
+	denied := []sdk.Msg{addAuthenticator, mint, &exec, &nestedExec}
+	for _, msg := range denied {
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+		authentication := s.AuthenticateMsgFrom(nftAuth, msg, s.TestPrivKeys[1])
+		s.Require().False(authentication.IsAuthenticated())
+		s.Require().True(authentication.IsAuthenticationFailed())
+
+		events := s.Ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonAdminMessageDenied)})
+		s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyMsgType), Value: []byte(sdk.MsgTypeURL(msg))})
+	}
+
+	//
+	// The account signing for itself isn't a holder, it is left to its own authenticators
+	// without being refused even when it holds the token
+	//
+	s.Mint(denom, 1, s.TestAccAddress[0])
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(s.AuthenticateMsgFrom(nftAuth, addAuthenticator, s.TestPrivKeys[0]).IsAuthenticationFailed())
+	s.Require().Empty(s.Ctx.EventManager().Events())
+
+	//
+	// Minting a denom that doesn't gate the account is allowed
+	//
+	other := &tokenfactorytypes.MsgMint{Sender: owner, Amount: sdk.NewInt64Coin("factory/"+owner+"/other", 1), MintToAddress: owner}
+	s.Require().True(s.AuthenticateMsgFrom(nftAuth, other, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// The owner can explicitly allow admin messages
+	//
+	nftAuth, err = s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","allow_admin_messages":true}`))
+	s.Require().NoError(err)
+	for _, msg := range denied {
+		s.Require().True(s.AuthenticateMsgFrom(nftAuth, msg, s.TestPrivKeys[1]).IsAuthenticated())
+	}
+}
+
+// TestSpendLimit checks that each holder can only spend up to the limit from the account within a sliding period
+func (s *NFTAuthenticatorTest) TestSpendLimit() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	s.Mint(denom, 1, s.TestAccAddress[2])
+	s.Mint("uosmo", 20_000, s.TestAccAddress[0])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","spend_limit":{"limit":"3000uosmo","period":"1h"}}`))
+	s.Require().NoError(err)
+
+	// spend authenticates a tx signed by the holder, executes a send of the amount
+	// from the account and confirms the execution
+	spend := func(holder cryptotypes.PrivKey, amount int64) iface.ConfirmationResult {
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+		msg := &banktypes.MsgSend{FromAddress: s.TestAccAddress[0].String(), ToAddress: s.TestAccAddress[1].String()}
+		authData := s.AuthenticationDataFrom(nftAuth, msg, holder)
+		s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, authData).IsAuthenticated())
+		s.Require().NoError(nftAuth.Track(s.Ctx, s.TestAccAddress[0], msg))
+		err := s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[0], sdk.AccAddress([]byte("spend_receiver______")), sdk.NewCoins(sdk.NewInt64Coin("uosmo", amount)))
+		s.Require().NoError(err)
+		return nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], msg, authData)
+	}
+
+	s.Require().True(spend(s.TestPrivKeys[1], 2000).IsConfirm())
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	s.Require().True(spend(s.TestPrivKeys[1], 1000).IsConfirm())
+	result := spend(s.TestPrivKeys[1], 1)
+	s.Require().True(result.IsBlock())
+	s.Require().ErrorContains(result.Error(), "exceeded the spend limit of 3000uosmo")
+
+	//
+	// Every holder has their own limit
+	//
+	s.Require().True(spend(s.TestPrivKeys[2], 3000).IsConfirm())
+
+	//
+	// The owner isn't limited, nothing is pending when the NFT authenticator didn't authenticate the tx
+	//
+	s.Require().True(nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], &banktypes.MsgSend{}, iface.EmptyAuthenticationData{}).IsConfirm())
+
+	//
+	// Spends leave the period one by one, the first spend no longer counts an hour after
+	// it but the second one still does
+	//
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Minute))
+	s.Require().True(spend(s.TestPrivKeys[1], 2000).IsConfirm())
+	result = spend(s.TestPrivKeys[1], 1)
+	s.Require().True(result.IsBlock())
+	s.Require().ErrorContains(result.Error(), "spent 3001uosmo within the last 1h0m0s")
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().True(spend(s.TestPrivKeys[1], 3000).IsConfirm())
+}
+
+// TestMaxUses checks that each holder can only execute max uses messages until the principal resets them
+func (s *NFTAuthenticatorTest) TestMaxUses() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","max_uses":2,"allow_admin_messages":true}`)
+
+	s.OsmosisApp.AuthenticatorManager.RegisterAuthenticator(s.NFT)
+	s.Require().NoError(s.OsmosisApp.AuthenticatorKeeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data))
+	authenticators, err := s.OsmosisApp.AuthenticatorKeeper.GetAuthenticatorDataForAccount(s.Ctx, s.TestAccAddress[0])
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+
+	nftAuth, err := s.NFT.Initialize(data)
+	s.Require().NoError(err)
+
+	//
+	// Messages executed through authz are tracked as well, the uses are counted per message.
+	// The MsgExec and the two messages it executes are three messages, the failing tx is
+	// executed in a cache context as its state is discarded
+	//
+	ctx := s.Ctx
+	transientStore := s.OsmosisApp.AuthenticatorKeeper.TransientStore
+	s.Ctx = transientStore.ResetTransientContext(ctx)
+	send := &banktypes.MsgSend{FromAddress: s.TestAccAddress[0].String(), ToAddress: s.TestAccAddress[1].String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1))}
+	exec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{send, send})
+	s.Require().True(s.AuthenticateMsgFrom(nftAuth, &exec, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().NoError(nftAuth.Track(s.Ctx, s.TestAccAddress[0], &exec))
+
+	s.Ctx, _ = ctx.CacheContext()
+	_, err = s.OsmosisApp.AuthzKeeper.DispatchActions(s.Ctx, s.TestAccAddress[0], []sdk.Msg{send, send})
+	s.Require().ErrorContains(err, "holder "+s.TestAccAddress[1].String()+" used 0 of 2 uses and the tx executes 3 messages")
+	s.Ctx = ctx
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 2))
+
+	// The uses aren't read while authenticating, every tx of the holder fails once
+	// its messages are tracked
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1), ReasonQuotaExhausted+": holder "+s.TestAccAddress[1].String()+" used 2 of 2 uses")
+
+	//
+	// Messages the owner signs aren't counted
+	//
+	s.Require().NoError(nftAuth.Track(s.Ctx, s.TestAccAddress[0], &banktypes.MsgSend{}))
+
+	//
+	// The holder can't reset their own uses, even when admin messages are allowed
+	//
+	reset := &MsgResetUses{Sender: s.TestAccAddress[0].String(), AuthenticatorId: authenticators[0].Id, Holder: s.TestAccAddress[1].String()}
+	s.Require().NoError(reset.ValidateBasic())
+	s.Require().False(s.AuthenticateMsgFrom(nftAuth, reset, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// The principal resets the uses with the msg server
+	//
+	_, err = NewMsgServerImpl(s.OsmosisApp.AuthenticatorKeeper, s.NFT.stateStoreKey).ResetUses(sdk.WrapSDKContext(s.Ctx), reset)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+
+	_, err = NewMsgServerImpl(s.OsmosisApp.AuthenticatorKeeper, s.NFT.stateStoreKey).ResetUses(sdk.WrapSDKContext(s.Ctx), &MsgResetUses{Sender: s.TestAccAddress[0].String(), AuthenticatorId: authenticators[0].Id + 1, Holder: s.TestAccAddress[1].String()})
+	s.Require().ErrorContains(err, "not found")
+}
+
+// TestRevokedHolders checks that the principal can revoke a holder by address or public key
+func (s *NFTAuthenticatorTest) TestRevokedHolders() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(denom)
+
+	s.OsmosisApp.AuthenticatorManager.RegisterAuthenticator(s.NFT)
+	s.Require().NoError(s.OsmosisApp.AuthenticatorKeeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data))
+	authenticators, err := s.OsmosisApp.AuthenticatorKeeper.GetAuthenticatorDataForAccount(s.Ctx, s.TestAccAddress[0])
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	id := authenticators[0].Id
+
+	nftAuth, err := s.NFT.Initialize(data)
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	msgServer := NewMsgServerImpl(s.OsmosisApp.AuthenticatorKeeper, s.NFT.stateStoreKey)
+	queryServer := NewQueryServerImpl(s.OsmosisApp.AuthenticatorKeeper, s.NFT.stateStoreKey)
+
+	//
+	// A revoked holder is blocked once the tx executed while still holding the NFT
+	//
+	revoke := &MsgRevokeHolder{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id, Holder: s.TestAccAddress[1].String()}
+	s.Require().NoError(revoke.ValidateBasic())
+	_, err = msgServer.RevokeHolder(sdk.WrapSDKContext(s.Ctx), revoke)
+	s.Require().NoError(err)
+
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1), ReasonHolderRevoked)
+
+	res, err := queryServer.RevokedHolders(sdk.WrapSDKContext(s.Ctx), &QueryRevokedHoldersRequest{Account: s.TestAccAddress[0].String(), AuthenticatorId: id})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{s.TestAccAddress[1].String()}, res.Holders)
+
+	//
+	// The holder can't unrevoke themselves, only the principal can
+	//
+	unrevoke := &MsgUnrevokeHolder{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id, Holder: s.TestAccAddress[1].String()}
+	s.Require().False(s.AuthenticateMsgFrom(nftAuth, unrevoke, s.TestPrivKeys[1]).IsAuthenticated())
+	_, err = msgServer.UnrevokeHolder(sdk.WrapSDKContext(s.Ctx), unrevoke)
+	s.Require().NoError(err)
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+
+	//
+	// Revoking the public key blocks the holder as well
+	//
+	pubKey := s.TestPrivKeys[1].PubKey().Bytes()
+	_, err = msgServer.RevokeHolder(sdk.WrapSDKContext(s.Ctx), &MsgRevokeHolder{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id, PubKey: pubKey})
+	s.Require().NoError(err)
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1), ReasonHolderRevoked)
+
+	res, err = queryServer.RevokedHolders(sdk.WrapSDKContext(s.Ctx), &QueryRevokedHoldersRequest{Account: s.TestAccAddress[0].String(), AuthenticatorId: id})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Holders)
+	s.Require().Equal([][]byte{pubKey}, res.PubKeys)
+
+	s.Require().ErrorContains((&MsgRevokeHolder{Sender: s.TestAccAddress[0].String(), Holder: s.TestAccAddress[1].String(), PubKey: pubKey}).ValidateBasic(), "exactly one of holder or pub key must be set")
+	_, err = queryServer.RevokedHolders(sdk.WrapSDKContext(s.Ctx), &QueryRevokedHoldersRequest{Account: s.TestAccAddress[0].String(), AuthenticatorId: id + 1})
+	s.Require().ErrorContains(err, "not found")
+}
+
+// TestPause checks that every holder is refused while the principal paused the authenticator
+func (s *NFTAuthenticatorTest) TestPause() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","allow_admin_messages":true}`)
+
+	s.OsmosisApp.AuthenticatorManager.RegisterAuthenticator(s.NFT)
+	s.Require().NoError(s.OsmosisApp.AuthenticatorKeeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data))
+	authenticators, err := s.OsmosisApp.AuthenticatorKeeper.GetAuthenticatorDataForAccount(s.Ctx, s.TestAccAddress[0])
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	id := authenticators[0].Id
+
+	nftAuth, err := s.NFT.Initialize(data)
+	s.Require().NoError(err)
+
+	msgServer := NewMsgServerImpl(s.OsmosisApp.AuthenticatorKeeper, s.NFT.stateStoreKey)
+	_, err = msgServer.Pause(sdk.WrapSDKContext(s.Ctx), &MsgPause{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id})
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+	s.Require().False(authentication.IsAuthenticated())
+	s.Require().True(authentication.IsAuthenticationFailed())
+
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonPaused)})
+
+	//
+	// The flag is checked before the signatures, even malformed data is refused as paused
+	//
+	resume := &MsgResume{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id}
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], resume, nil).IsAuthenticationFailed())
+	events = s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonPaused)})
+
+	//
+	// The holder can't resume the authenticator, even when admin messages are allowed
+	//
+	s.Require().NoError(resume.ValidateBasic())
+	s.Require().False(s.AuthenticateMsgFrom(nftAuth, resume, s.TestPrivKeys[1]).IsAuthenticated())
+	exec := authz.NewMsgExec(s.TestAccAddress[0], []sdk.Msg{resume})
+	s.Require().False(s.AuthenticateMsgFrom(nftAuth, &exec, s.TestPrivKeys[1]).IsAuthenticated())
+
+	_, err = msgServer.Resume(sdk.WrapSDKContext(s.Ctx), resume)
+	s.Require().NoError(err)
+
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+}
+
+// TestDuplicateConfig checks that an account can't add the same config twice, the
+// authenticators would share the state kept for their holders
+func (s *NFTAuthenticatorTest) TestDuplicateConfig() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","max_uses":1}`)
+
+	keeper := s.OsmosisApp.AuthenticatorKeeper
+	s.OsmosisApp.AuthenticatorManager.RegisterAuthenticator(s.NFT)
+	s.Require().NoError(keeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data))
+	authenticators, err := keeper.GetAuthenticatorDataForAccount(s.Ctx, s.TestAccAddress[0])
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+
+	err = keeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data)
+	s.Require().ErrorContains(err, fmt.Sprintf("already has NFTAuthenticator %d with the same config", authenticators[0].Id))
+}
+
+// TestReaddAuthenticator checks that removing an authenticator deletes its state, so
+// adding the same config again starts afresh
+func (s *NFTAuthenticatorTest) TestReaddAuthenticator() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","max_uses":2,"allow_admin_messages":true}`)
+
+	keeper := s.OsmosisApp.AuthenticatorKeeper
+	s.OsmosisApp.AuthenticatorManager.RegisterAuthenticator(s.NFT)
+	addAuthenticator := func() uint64 {
+		s.Require().NoError(keeper.AddAuthenticator(s.Ctx, s.TestAccAddress[0], NFTAuthenticatorType, data))
+		authenticators, err := keeper.GetAuthenticatorDataForAccount(s.Ctx, s.TestAccAddress[0])
+		s.Require().NoError(err)
+		s.Require().Len(authenticators, 1)
+		return authenticators[0].Id
+	}
+	id := addAuthenticator()
+
+	//
+	// The holder uses the authenticator and the principal pauses it
+	//
+	nftAuth, err := s.NFT.Initialize(data)
+	s.Require().NoError(err)
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+	_, err = NewMsgServerImpl(keeper, s.NFT.stateStoreKey).Pause(sdk.WrapSDKContext(s.Ctx), &MsgPause{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id})
+	s.Require().NoError(err)
+
+	store := nftAuth.(NFTAuthenticator).getStore(s.Ctx, s.TestAccAddress[0])
+	s.Require().True(isPaused(store))
+	s.Require().Equal(uint64(1), getUses(store, s.TestAccAddress[1].String()))
+
+	//
+	// Removing the authenticator deletes its state
+	//
+	s.Require().NoError(keeper.RemoveAuthenticator(s.Ctx, s.TestAccAddress[0], id))
+
+	iterator := store.Iterator(nil, nil)
+	s.Require().False(iterator.Valid())
+	iterator.Close()
+
+	//
+	// The config added again is neither paused nor used up
+	//
+	id = addAuthenticator()
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 2))
+
+	//
+	// A holder removes the authenticator, the execution of that tx is never confirmed for
+	// it and is dropped once the config is added again
+	//
+	_, err = NewMsgServerImpl(keeper, s.NFT.stateStoreKey).ResetUses(sdk.WrapSDKContext(s.Ctx), &MsgResetUses{Sender: s.TestAccAddress[0].String(), AuthenticatorId: id, Holder: s.TestAccAddress[1].String()})
+	s.Require().NoError(err)
+	remove := &authenticatortypes.MsgRemoveAuthenticator{Sender: s.TestAccAddress[0].String(), Id: id}
+	removeCtx := s.Ctx.WithTxBytes([]byte("remove")).WithEventManager(sdk.NewEventManager())
+	s.Require().True(nftAuth.Authenticate(removeCtx, s.TestAccAddress[0], remove, s.AuthenticationDataFrom(nftAuth, remove, s.TestPrivKeys[1])).IsAuthenticated())
+	s.Require().NoError(nftAuth.Track(removeCtx, s.TestAccAddress[0], remove))
+	s.Require().NoError(keeper.RemoveAuthenticator(removeCtx, s.TestAccAddress[0], id))
+
+	addAuthenticator()
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 2))
+}
+
+// TestRateLimit checks that each holder can only execute the messages of the rate limit within the window
+func (s *NFTAuthenticatorTest) TestRateLimit() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	s.Mint(denom, 1, s.TestAccAddress[2])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","rate_limit":{"messages":2,"blocks":10}}`))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+	s.Ctx = s.Ctx.WithBlockHeight(5)
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+
+	//
+	// A burst fails until the first execution leaves the window
+	//
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1), ReasonRateLimited+": holder "+s.TestAccAddress[1].String()+" executed 2 of 2 messages")
+
+	//
+	// Every holder has their own window
+	//
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[2], 2))
+
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 2), "executed 1 of 2 messages within the window and the tx executes 2 messages")
+
+	//
+	// The window can also be set in seconds
+	//
+	nftAuth, err = s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","rate_limit":{"messages":1,"seconds":60}}`))
+	s.Require().NoError(err)
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+	s.Require().ErrorContains(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1), ReasonRateLimited)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+}
+
+// TestMinHoldingBlocks checks that a holder is refused until it held the denom for the
+// configured number of blocks, as recorded by the HoldingTracker
+func (s *NFTAuthenticatorTest) TestMinHoldingBlocks() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","min_holding_blocks":5}`)
+
+	// The bank hooks of the app are already set, so sends call the tracker directly
+	tracker := NewHoldingTracker(
+		s.OsmosisApp.GetKey(authenticatortypes.ManagerStoreKey),
+		s.NFT.stateStoreKey,
+		s.OsmosisApp.BankKeeper,
+	)
+	send := func(from, to sdk.AccAddress) {
+		coins := sdk.Coins{sdk.NewInt64Coin(denom, 1)}
+		tracker.TrackBeforeSend(s.Ctx, from, to, coins)
+		s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, from, to, coins))
+	}
+
+	s.Require().ErrorContains(s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], data), "requires the bank checker to have a holding tracker")
+	s.Ctx = s.Ctx.WithBlockHeight(2)
+	trackedAuth := s.NFT.WithHoldingTracker(tracker)
+	s.Require().NoError(trackedAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], data))
+	nftAuth, err := trackedAuth.Initialize(data)
+	s.Require().NoError(err)
+
+	// The denom is gated once the execution of the tx adding the authenticator is confirmed
+	s.Require().True(nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], &banktypes.MsgSend{}, iface.EmptyAuthenticationData{}).IsConfirm())
+
+	//
+	// The NFT was held before the denom was gated, so it counts as held since the gate
+	//
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+	s.Require().True(authentication.IsAuthenticationFailed())
+	events := s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonHoldingPeriodNotMet)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyHeldSince), Value: []byte("2")})
+
+	s.Ctx = s.Ctx.WithBlockHeight(7)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	//
+	// A new holder is refused until the holding period passed
+	//
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	send(s.TestAccAddress[1], s.TestAccAddress[2])
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+
+	s.Ctx = s.Ctx.WithBlockHeight(14).WithEventManager(sdk.NewEventManager())
+	authentication = s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2])
+	s.Require().True(authentication.IsAuthenticationFailed())
+	events = s.Ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(ReasonHoldingPeriodNotMet)})
+	s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyHeldSince), Value: []byte("10")})
+
+	s.Ctx = s.Ctx.WithBlockHeight(15)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// Handing the NFT back and forth restarts the holding period
+	//
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	send(s.TestAccAddress[2], s.TestAccAddress[1])
+	s.Ctx = s.Ctx.WithBlockHeight(21)
+	send(s.TestAccAddress[1], s.TestAccAddress[2])
+
+	s.Ctx = s.Ctx.WithBlockHeight(25)
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+	s.Ctx = s.Ctx.WithBlockHeight(26)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+}
+
+// TestHoldingTrackerGatedDenoms checks that the HoldingTracker only records the denoms
+// and collections gated by a config with a min_holding_blocks
+func (s *NFTAuthenticatorTest) TestHoldingTrackerGatedDenoms() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+	member := s.CreateNFT("member-1", s.TestAccAddress[1])
+	data := []byte(`{"version":1,"denom":"` + denom + `","min_holding_blocks":5}`)
+	collectionData := []byte(`{"version":1,"requirements":[{"collection":{"creator":"` + s.TestAccAddress[0].String() + `","subdenom_prefix":"member-"}}],"min_holding_blocks":5}`)
+
+	tracker := NewHoldingTracker(
+		s.OsmosisApp.GetKey(authenticatortypes.ManagerStoreKey),
+		s.NFT.stateStoreKey,
+		s.OsmosisApp.BankKeeper,
+	)
+	trackedAuth := s.NFT.WithHoldingTracker(tracker)
+	add := func(data []byte) {
+		s.Require().NoError(trackedAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], data))
+		nftAuth, err := trackedAuth.Initialize(data)
+		s.Require().NoError(err)
+		s.Require().True(nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], &banktypes.MsgSend{}, iface.EmptyAuthenticationData{}).IsConfirm())
+	}
+	send := func(denom string, from, to sdk.AccAddress) {
+		coins := sdk.Coins{sdk.NewInt64Coin(denom, 1)}
+		tracker.TrackBeforeSend(s.Ctx, from, to, coins)
+		s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, from, to, coins))
+	}
+	holdings := func() int {
+		iterator := tracker.getStore(s.Ctx).Iterator(nil, nil)
+		defer iterator.Close()
+		count := 0
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		return count
+	}
+
+	//
+	// Transfers of denoms no config gates on aren't recorded
+	//
+	send(denom, s.TestAccAddress[1], s.TestAccAddress[2])
+	send(member, s.TestAccAddress[1], s.TestAccAddress[2])
+	s.Require().Zero(holdings())
+
+	//
+	// Once a config gates the denom its transfers are recorded, until it is removed
+	//
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	add(data)
+	send(denom, s.TestAccAddress[2], s.TestAccAddress[1])
+	send(member, s.TestAccAddress[2], s.TestAccAddress[1])
+	s.Require().Equal(1, holdings())
+	heldSince, found := tracker.HeldSince(s.Ctx, s.TestAccAddress[1], denom)
+	s.Require().True(found)
+	s.Require().Equal(int64(10), heldSince)
+
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+	s.Require().NoError(trackedAuth.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], data))
+	_, found = tracker.HeldSince(s.Ctx, s.TestAccAddress[1], denom)
+	s.Require().False(found)
+
+	//
+	// The height recorded before the denom was gated again isn't trusted, the NFT may
+	// have changed hands in between, so it is held since the denom was gated again
+	//
+	send(denom, s.TestAccAddress[1], s.TestAccAddress[2])
+	send(denom, s.TestAccAddress[2], s.TestAccAddress[1])
+	s.Ctx = s.Ctx.WithBlockHeight(30)
+	add(data)
+	heldSince, found = tracker.HeldSince(s.Ctx, s.TestAccAddress[1], denom)
+	s.Require().True(found)
+	s.Require().Equal(int64(30), heldSince)
+
+	//
+	// The denoms of a gated collection are recorded
+	//
+	add(collectionData)
+	send(member, s.TestAccAddress[1], s.TestAccAddress[2])
+	heldSince, found = tracker.HeldSince(s.Ctx, s.TestAccAddress[2], member)
+	s.Require().True(found)
+	s.Require().Equal(int64(30), heldSince)
+}
+
+// TestNFTMovedDuringTx checks that a tx signed by a holder can't hand the NFT to someone else
+func (s *NFTAuthenticatorTest) TestNFTMovedDuringTx() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+
+	nftAuth, err := s.NFT.Initialize([]byte(denom))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ExecuteFrom(nftAuth, s.TestPrivKeys[1], 1))
+
+	//
+	// The NFT is sent back to the principal while the tx executes
+	//
+	msg := &banktypes.MsgSend{
+		FromAddress: s.TestAccAddress[0].String(),
+		ToAddress:   s.TestAccAddress[1].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+	}
+	ctx := s.Ctx
+	s.Ctx, _ = s.Ctx.CacheContext()
+	authData := s.AuthenticationDataFrom(nftAuth, msg, s.TestPrivKeys[1])
+	s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, authData).IsAuthenticated())
+	store := nftAuth.(NFTAuthenticator).getPendingStore(s.Ctx, s.TestAccAddress[0])
+	s.Require().False(store.Has(pendingExecutionKey), "authenticate must not write with the fee payer gas")
+	s.Require().NoError(nftAuth.Track(s.Ctx, s.TestAccAddress[0], msg))
+	s.Require().True(store.Has(pendingExecutionKey))
+	s.Require().True(store.Has(getPendingMessageKey(0)))
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[1], s.TestAccAddress[0], sdk.Coins{sdk.NewInt64Coin(denom, 1)}))
+	confirmation := nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], msg, authData)
+	s.Require().ErrorContains(confirmation.Error(), "no longer holds the gating tokens after the tx")
+	s.Ctx = ctx
+
+	//
+	// A holder named in an event without signing the message is blocked
+	//
+	s.Mint(denom, 1, s.TestAccAddress[2])
+	ctx = s.Ctx
+	s.Ctx, _ = s.Ctx.CacheContext()
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, authData).IsAuthenticated())
+	emitAuthenticated(s.Ctx, s.TestAccAddress[0], s.TestAccAddress[2], nftAuth.(NFTAuthenticator).id, 0, []string{denom})
+	s.Require().NoError(nftAuth.Track(s.Ctx, s.TestAccAddress[0], msg))
+	confirmation = nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], msg, authData)
+	s.Require().ErrorContains(confirmation.Error(), "holder "+s.TestAccAddress[2].String()+" didn't sign message 0")
+	s.Ctx = ctx
+
+	//
+	// Another authenticator of the account confirms without a pending execution
+	//
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.TestAccAddress[1], s.TestAccAddress[0], sdk.Coins{sdk.NewInt64Coin(denom, 1)}))
+	s.Require().NoError(nftAuth.ConfirmExecution(s.Ctx, s.TestAccAddress[0], msg, authData).Error())
+}
+
+// TestValidityWindow checks that holders are only authenticated within the validity window of the config
+func (s *NFTAuthenticatorTest) TestValidityWindow() {
+	denom := s.CreateNFT("nft", s.TestAccAddress[1])
+
+	nftAuth, err := s.NFT.Initialize([]byte(`{"version":1,"denom":"` + denom + `","not_before":"2024-01-01T00:00:00Z","not_after":"2024-12-31T23:59:59Z"}`))
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		reason    string
+		attribute abci.EventAttribute
+	}{
+		{
+			name:      "before the window",
+			blockTime: time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+			reason:    ReasonNotYetValid,
+			attribute: abci.EventAttribute{Key: []byte(AttributeKeyNotBefore), Value: []byte("2024-01-01T00:00:00Z")},
+		},
+		{
+			name:      "start of the window",
+			blockTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "end of the window",
+			blockTime: time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "expired",
+			blockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			reason:    ReasonExpired,
+			attribute: abci.EventAttribute{Key: []byte(AttributeKeyNotAfter), Value: []byte("2024-12-31T23:59:59Z")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Ctx = s.Ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+			authentication := s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1])
+			if tc.reason == "" {
+				s.Require().True(authentication.IsAuthenticated())
+				return
+			}
+			s.Require().False(authentication.IsAuthenticated())
+			s.Require().True(authentication.IsAuthenticationFailed())
+
+			events := s.Ctx.EventManager().Events()
+			s.Require().Len(events, 1)
+			s.Require().Equal(EventTypeAuthenticationRefused, events[0].Type)
+			s.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: []byte(AttributeKeyReason), Value: []byte(tc.reason)})
+			s.Require().Contains(events[0].Attributes, tc.attribute)
+		})
+	}
+}
+
+// TestCustomChecker checks that a chain can plug in its own notion of ownership
+func (s *NFTAuthenticatorTest) TestCustomChecker() {
+	customAuth := NewNFTAuthenticator(
+		s.OsmosisApp.BankKeeper,
+		s.OsmosisApp.GetKey(banktypes.StoreKey),
+		*s.OsmosisApp.TokenFactoryKeeper,
+		s.NFT.storeKey,
+		s.NFT.stateStoreKey,
+		s.NFT.sva,
+		allowlistChecker{},
+	).WithAuthenticatorKeeper(s.OsmosisApp.AuthenticatorKeeper)
+	config := []byte(`{"version":1,"requirements":[{"checker":"allowlist","params":"` + s.TestAccAddress[1].String() + `"}]}`)
+
+	//
+	// The checker validates its params and must be registered
+	//
+	s.Require().NoError(customAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config))
+	err := customAuth.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], []byte(`{"version":1,"requirements":[{"checker":"allowlist"}]}`))
+	s.Require().ErrorContains(err, "empty allowlist")
+	err = s.NFT.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config)
+	s.Require().ErrorContains(err, "ownership checker allowlist is not registered")
+
+	nftAuth, err := customAuth.Initialize(config)
+	s.Require().NoError(err)
+	s.Require().True(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[1]).IsAuthenticated())
+	s.Require().False(s.AuthenticateFrom(nftAuth, s.TestPrivKeys[2]).IsAuthenticated())
+
+	//
+	// A checker can't be registered twice
+	//
+	s.Require().Panics(func() {
+		NewNFTAuthenticator(s.OsmosisApp.BankKeeper, s.OsmosisApp.GetKey(banktypes.StoreKey), *s.OsmosisApp.TokenFactoryKeeper, s.NFT.storeKey, s.NFT.stateStoreKey, s.NFT.sva, NewBankChecker(s.OsmosisApp.BankKeeper, s.OsmosisApp.GetKey(banktypes.StoreKey), *s.OsmosisApp.TokenFactoryKeeper))
+	})
+}
+
+// allowlistChecker is a custom checker where the params are the addresses that own the token
+type allowlistChecker struct{}
+
+func (allowlistChecker) Name() string {
+	return "allowlist"
+}
+
+func (allowlistChecker) ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error {
+	if requirement.Params == "" {
+		return fmt.Errorf("empty allowlist")
+	}
+	return nil
+}
+
+func (allowlistChecker) Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error) {
+	for _, address := range strings.Split(requirement.Params, ",") {
+		if address == holder.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// mockCW721 is an in memory CW721 contract
+type mockCW721 struct {
+	owners map[string]string
+	err    bool
+	gas    uint64
+}
+
+// mockWasmKeeper answers the CW721 queries of the contracts by address
+type mockWasmKeeper map[string]mockCW721
+
+func (k mockWasmKeeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	contract := k[contractAddr.String()]
+	ctx.GasMeter().ConsumeGas(contract.gas, "mock cw721 query")
+	if contract.err {
+		return nil, fmt.Errorf("contract error")
+	}
+
+	var query cw721QueryMsg
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	if query.OwnerOf != nil {
+		return json.Marshal(cw721OwnerOfResponse{Owner: contract.owners[query.OwnerOf.TokenID]})
+	}
+
+	tokens := []string{}
+	for tokenID, owner := range contract.owners {
+		if owner == query.Tokens.Owner {
+			tokens = append(tokens, tokenID)
+		}
+	}
+	return json.Marshal(cw721TokensResponse{Tokens: tokens})
+}
+
+func (k mockWasmKeeper) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	_, ok := k[contractAddress.String()]
+	return ok
+}
+
+// mockNFTKeeper is an in memory x/nft keeper mapping class IDs to NFT IDs to owners
+type mockNFTKeeper map[string]map[string]sdk.AccAddress
+
+func (k mockNFTKeeper) HasClass(ctx sdk.Context, classID string) bool {
+	_, ok := k[classID]
+	return ok
+}
+
+func (k mockNFTKeeper) HasNFT(ctx sdk.Context, classID, id string) bool {
+	_, ok := k[classID][id]
+	return ok
+}
+
+func (k mockNFTKeeper) GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress {
+	return k[classID][nftID]
+}
+
+func (k mockNFTKeeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	var balance uint64
+	for _, nftOwner := range k[classID] {
+		if nftOwner.Equals(owner) {
+			balance++
+		}
+	}
+	return balance
+}
+
+// AuthenticateFromMultisig authenticates a MsgSend from Account 0 signed by the members of the multisig
+func (s *NFTAuthenticatorTest) AuthenticateFromMultisig(
+	nftAuth iface.Authenticator,
+	multisigKey *kmultisig.LegacyAminoPubKey,
+	members ...cryptotypes.PrivKey,
+) iface.AuthenticationResult {
+	msg := &banktypes.MsgSend{
+		FromAddress: s.TestAccAddress[0].String(),
+		ToAddress:   s.TestAccAddress[1].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("osmo", 2500)},
+	}
+
+	txBuilder := s.EncodingConfig.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin("osmo", 2500)})
+	txBuilder.SetGasLimit(300000)
+
+	// Multisig members sign with amino json
+	signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	multisigData := multisig.NewMultisig(len(multisigKey.GetPubKeys()))
+	sig := signing.SignatureV2{PubKey: multisigKey, Data: multisigData}
+	s.Require().NoError(txBuilder.SetSignatures(sig))
+
+	signBytes, err := s.EncodingConfig.TxConfig.SignModeHandler().GetSignBytes(signMode, authsigning.SignerData{}, txBuilder.GetTx())
+	s.Require().NoError(err)
+	for _, member := range members {
+		memberSig, err := member.Sign(signBytes)
+		s.Require().NoError(err)
+		err = multisig.AddSignatureV2(multisigData, signing.SignatureV2{
+			PubKey: member.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signMode, Signature: memberSig},
+		}, multisigKey.GetPubKeys())
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sig))
+
+	authData, err := nftAuth.GetAuthenticationData(s.Ctx, txBuilder.GetTx(), 0, false)
+	s.Require().NoError(err)
+
+	return nftAuth.Authenticate(s.Ctx, s.TestAccAddress[0], msg, authData)
+}
+
 // GenTx is a helper function to generate a signed mock transaction.
 func GenTx(
 	gen client.TxConfig,
@@ -0,0 +1,49 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ OwnershipChecker = NFTModuleChecker{}
+
+// NFTModuleChecker checks the ownership of NFTs in the Cosmos SDK x/nft module
+type NFTModuleChecker struct {
+	nftKeeper NFTKeeper
+}
+
+// NewNFTModuleChecker creates the checker for x/nft requirements, chains with the
+// x/nft module register it with the NFTAuthenticator
+func NewNFTModuleChecker(nftKeeper NFTKeeper) NFTModuleChecker {
+	return NFTModuleChecker{
+		nftKeeper: nftKeeper,
+	}
+}
+
+func (nc NFTModuleChecker) Name() string {
+	return CheckerNFT
+}
+
+// ValidateRequirement ensures the class, and the NFT when an ID is given, exist.
+// x/nft classes have no admin that can be checked
+func (nc NFTModuleChecker) ValidateRequirement(ctx sdk.Context, account sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) error {
+	if !nc.nftKeeper.HasClass(ctx, requirement.NFTClassID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft class %s does not exist", requirement.NFTClassID)
+	}
+	if requirement.NFTID != "" && !nc.nftKeeper.HasNFT(ctx, requirement.NFTClassID, requirement.NFTID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %s of class %s does not exist", requirement.NFTID, requirement.NFTClassID)
+	}
+	return nil
+}
+
+// Owns checks the holder owns the NFT with the given ID or any NFT of the class when
+// no ID is set. The amount bounds and strict supply only apply to bank denoms, an
+// x/nft NFT is unique by definition
+func (nc NFTModuleChecker) Owns(ctx sdk.Context, holder sdk.AccAddress, config NFTAuthenticatorConfig, requirement Requirement) (bool, error) {
+	ctx.GasMeter().ConsumeGas(DenomCheckGas, "nft authenticator denom check")
+
+	if requirement.NFTID != "" {
+		return holder.Equals(nc.nftKeeper.GetOwner(ctx, requirement.NFTClassID, requirement.NFTID)), nil
+	}
+	return nc.nftKeeper.GetBalance(ctx, requirement.NFTClassID, holder) > 0, nil
+}
@@ -0,0 +1,14 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+)
+
+// pausedKey is set while the principal paused the authenticator
+var pausedKey = []byte("paused")
+
+// isPaused returns true while the principal paused the authenticator, the store is
+// the state of the authenticator for the account
+func isPaused(store prefix.Store) bool {
+	return store.Has(pausedKey)
+}
@@ -0,0 +1,45 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	authenticatorkeeper "github.com/osmosis-labs/osmosis/v19/x/authenticator/keeper"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	authenticatorKeeper *authenticatorkeeper.Keeper
+	storeKey            sdk.StoreKey
+}
+
+// NewQueryServerImpl creates the query server, the store key must be the state store
+// key the NFTAuthenticator was created with. Chains register it with RegisterQueryServer
+func NewQueryServerImpl(authenticatorKeeper *authenticatorkeeper.Keeper, storeKey sdk.StoreKey) QueryServer {
+	return queryServer{
+		authenticatorKeeper: authenticatorKeeper,
+		storeKey:            storeKey,
+	}
+}
+
+// RevokedHolders returns the revocation list of the NFTAuthenticator
+func (q queryServer) RevokedHolders(goCtx context.Context, req *QueryRevokedHoldersRequest) (*QueryRevokedHoldersResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %q: %s", req.Account, err)
+	}
+
+	id, err := getAuthenticatorID(ctx, q.authenticatorKeeper, account, req.AuthenticatorId)
+	if err != nil {
+		return nil, err
+	}
+	revoked := getRevokedHolders(getAccountStore(ctx.KVStore(q.storeKey), account, id))
+	return &QueryRevokedHoldersResponse{Holders: revoked.Holders, PubKeys: revoked.PubKeys}, nil
+}
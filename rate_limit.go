@@ -0,0 +1,83 @@
+package nft
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/utils"
+)
+
+// rateWindow holds the executions of a holder that may still be within the window,
+// there are never more executions than the messages of the rate limit
+type rateWindow struct {
+	Executions []execution `json:"executions"`
+}
+
+// execution is a tx executed for the account while a holder was authenticated
+type execution struct {
+	Height   int64     `json:"height"`
+	Time     time.Time `json:"time"`
+	Messages uint64    `json:"messages"`
+}
+
+func getRateWindowKey(holder string) []byte {
+	return utils.BuildKey("rate", holder)
+}
+
+// inWindow checks if the execution is within the sliding window ending at the current block
+func (l RateLimit) inWindow(ctx sdk.Context, e execution) bool {
+	if l.Blocks > 0 {
+		return ctx.BlockHeight()-e.Height < int64(l.Blocks)
+	}
+	return ctx.BlockTime().Sub(e.Time) < time.Duration(l.Seconds)*time.Second
+}
+
+// getWindow returns the executions of the holder within the window and the number
+// of messages they executed
+func (na NFTAuthenticator) getWindow(ctx sdk.Context, store prefix.Store, holder string) (rateWindow, uint64) {
+	var stored, window rateWindow
+	getState(store, getRateWindowKey(holder), &stored)
+
+	var messages uint64
+	for _, e := range stored.Executions {
+		if na.config.RateLimit.inWindow(ctx, e) {
+			window.Executions = append(window.Executions, e)
+			messages += e.Messages
+		}
+	}
+	return window, messages
+}
+
+// checkRateLimit fails when the messages tracked for the tx would take a holder past
+// the rate limit of the config
+func (na NFTAuthenticator) checkRateLimit(ctx sdk.Context, store prefix.Store, pending pendingExecution) error {
+	if na.config.RateLimit == nil {
+		return nil
+	}
+	for _, holder := range pending.Holders {
+		if _, messages := na.getWindow(ctx, store, holder); messages+pending.Messages > na.config.RateLimit.Messages {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s: holder %s executed %d of %d messages within the window and the tx executes %d messages", ReasonRateLimited, holder, messages, na.config.RateLimit.Messages, pending.Messages)
+		}
+	}
+	return nil
+}
+
+// chargeRateLimit records the execution in the window of every holder, executions
+// that left the window are dropped
+func (na NFTAuthenticator) chargeRateLimit(ctx sdk.Context, store prefix.Store, pending pendingExecution) {
+	if na.config.RateLimit == nil || pending.Messages == 0 {
+		return
+	}
+	for _, holder := range pending.Holders {
+		window, _ := na.getWindow(ctx, store, holder)
+		window.Executions = append(window.Executions, execution{
+			Height:   ctx.BlockHeight(),
+			Time:     ctx.BlockTime(),
+			Messages: pending.Messages,
+		})
+		setState(store, getRateWindowKey(holder), window)
+	}
+}
@@ -0,0 +1,104 @@
+package nft
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRevokedHolders is the most addresses and public keys the revocation list of an
+// authenticator can hold
+const MaxRevokedHolders = 100
+
+// revokedHoldersKey stores the revocation list the principal manages for the account
+var revokedHoldersKey = []byte("revoked")
+
+// revokedHolders are blocked whatever they hold. The list is kept under a single key
+// so checking a signer is a single read
+type revokedHolders struct {
+	Holders []string `json:"holders,omitempty"`
+	PubKeys [][]byte `json:"pub_keys,omitempty"`
+}
+
+func getRevokedHolders(store prefix.Store) revokedHolders {
+	var revoked revokedHolders
+	getState(store, revokedHoldersKey, &revoked)
+	return revoked
+}
+
+// setRevokedHolders stores the list, the key is deleted once the list is empty
+func setRevokedHolders(store prefix.Store, revoked revokedHolders) {
+	if len(revoked.Holders) == 0 && len(revoked.PubKeys) == 0 {
+		store.Delete(revokedHoldersKey)
+		return
+	}
+	setState(store, revokedHoldersKey, revoked)
+}
+
+// isRevoked returns true when the address of the holder or the public key it signed
+// with is revoked, a multisig is revoked when any of its keys is
+func (r revokedHolders) isRevoked(holder sdk.AccAddress, pubKey cryptotypes.PubKey) bool {
+	if containsString(r.Holders, holder.String()) {
+		return true
+	}
+	return r.isPubKeyRevoked(pubKey)
+}
+
+func (r revokedHolders) isPubKeyRevoked(pubKey cryptotypes.PubKey) bool {
+	if pubKey == nil {
+		return false
+	}
+	for _, revoked := range r.PubKeys {
+		if bytes.Equal(revoked, pubKey.Bytes()) {
+			return true
+		}
+	}
+	if multisigKey, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+		for _, subKey := range multisigKey.GetPubKeys() {
+			if r.isPubKeyRevoked(subKey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// revoke adds the holder or the public key to the list, entries are only listed once
+func (r revokedHolders) revoke(holder string, pubKey []byte) revokedHolders {
+	if holder != "" && !containsString(r.Holders, holder) {
+		r.Holders = append(r.Holders, holder)
+	}
+	if len(pubKey) > 0 && !containsBytes(r.PubKeys, pubKey) {
+		r.PubKeys = append(r.PubKeys, pubKey)
+	}
+	return r
+}
+
+// unrevoke removes the holder or the public key from the list
+func (r revokedHolders) unrevoke(holder string, pubKey []byte) revokedHolders {
+	holders := make([]string, 0, len(r.Holders))
+	for _, revoked := range r.Holders {
+		if revoked != holder {
+			holders = append(holders, revoked)
+		}
+	}
+	pubKeys := make([][]byte, 0, len(r.PubKeys))
+	for _, revoked := range r.PubKeys {
+		if !bytes.Equal(revoked, pubKey) {
+			pubKeys = append(pubKeys, revoked)
+		}
+	}
+	return revokedHolders{Holders: holders, PubKeys: pubKeys}
+}
+
+func containsBytes(values [][]byte, value []byte) bool {
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+#!/usr/bin/env bash
+
+# Generates the go types of the proto files, it requires buf and protoc-gen-gocosmos
+# from github.com/regen-network/cosmos-proto
+set -eo pipefail
+
+cd proto
+buf mod update
+buf generate --template buf.gen.gogo.yaml
+cd ..
+
+cp github.com/PaddyMc/nft-authenticator/*.pb.go ./
+rm -rf github.com
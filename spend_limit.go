@@ -0,0 +1,83 @@
+package nft
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/utils"
+)
+
+// spendWindow holds the spends of a holder that may still be within the period
+type spendWindow struct {
+	Spends []spend `json:"spends"`
+}
+
+// spend is what the account spent in a tx a holder authenticated
+type spend struct {
+	Time  time.Time `json:"time"`
+	Coins sdk.Coins `json:"coins"`
+}
+
+func getSpendWindowKey(holder string) []byte {
+	return utils.BuildKey("spent", holder)
+}
+
+// getSpendWindow returns the spends of the holder within the sliding period ending at
+// the current block and what they spent in total
+func getSpendWindow(ctx sdk.Context, store prefix.Store, holder string, period time.Duration) (spendWindow, sdk.Coins) {
+	var stored, window spendWindow
+	getState(store, getSpendWindowKey(holder), &stored)
+
+	spent := sdk.NewCoins()
+	for _, s := range stored.Spends {
+		if ctx.BlockTime().Sub(s.Time) < period {
+			window.Spends = append(window.Spends, s)
+			spent = spent.Add(s.Coins...)
+		}
+	}
+	return window, spent
+}
+
+// chargeSpendLimit adds what the account spent during the tx to the window of every
+// holder that authenticated it and fails if a holder exceeds the limit. The period is
+// a sliding window ending at the current block, spends older than the period are dropped
+func (na NFTAuthenticator) chargeSpendLimit(ctx sdk.Context, store prefix.Store, account sdk.AccAddress, pending pendingExecution) error {
+	if na.config.SpendLimit == nil {
+		return nil
+	}
+	limit, err := na.config.SpendLimit.Coins()
+	if err != nil {
+		return err
+	}
+	period, err := na.config.SpendLimit.Duration()
+	if err != nil {
+		return err
+	}
+
+	// Only decreases count, receiving one denom doesn't make up for spending another
+	spent := sdk.NewCoins()
+	for _, before := range pending.Balances {
+		after := na.bankKeeper.GetBalance(ctx, account, before.Denom)
+		if before.Amount.GT(after.Amount) {
+			spent = spent.Add(sdk.NewCoin(before.Denom, before.Amount.Sub(after.Amount)))
+		}
+	}
+	if spent.IsZero() {
+		return nil
+	}
+
+	for _, holder := range pending.Holders {
+		window, total := getSpendWindow(ctx, store, holder, period)
+		total = total.Add(spent...)
+		if !total.IsAllLTE(limit) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "holder %s exceeded the spend limit of %s, spent %s within the last %s", holder, limit, total, period)
+		}
+
+		window.Spends = append(window.Spends, spend{Time: ctx.BlockTime(), Coins: spent})
+		setState(store, getSpendWindowKey(holder), window)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package nft
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/utils"
+)
+
+// pendingExecutionKey stores the holders that authenticated the current tx
+var pendingExecutionKey = []byte("pending")
+
+// getPendingMessageKey stores the holders that authenticated the message at the index
+// of the current tx
+func getPendingMessageKey(messageIndex int) []byte {
+	return utils.BuildKey("pending", messageIndex)
+}
+
+// pendingExecution is recorded by Track for the holders that authenticated a tx and is
+// consumed by ConfirmExecution once the messages have been executed
+type pendingExecution struct {
+	// Tx is the hash of the tx the execution is pending for
+	Tx      string   `json:"tx"`
+	Holders []string `json:"holders"`
+	// Messages is the number of messages tracked for the account since the holders
+	// authenticated the tx, including the messages executed through authz or ICA
+	Messages uint64 `json:"messages,omitempty"`
+	// Balances of the spend limit denoms before the tx was executed
+	Balances []sdk.Coin `json:"balances,omitempty"`
+}
+
+// authenticatorID identifies the authenticator of an account in state by hashing the
+// data it was added with, an account can't add the same data twice
+func authenticatorID(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// getAccountStore returns the state of the authenticator with the id for the account
+func getAccountStore(kvStore sdk.KVStore, account sdk.AccAddress, id string) prefix.Store {
+	return prefix.NewStore(kvStore, utils.BuildKey(NFTAuthenticatorType, account, id))
+}
+
+// deleteState deletes the state of the authenticator with the id for the account
+func deleteState(kvStore sdk.KVStore, account sdk.AccAddress, id string) {
+	store := getAccountStore(kvStore, account, id)
+
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getStore returns the state this authenticator keeps for the holders of the account,
+// it is written by the msg server and once a tx executed
+func (na NFTAuthenticator) getStore(ctx sdk.Context, account sdk.AccAddress) prefix.Store {
+	return getAccountStore(ctx.KVStore(na.stateStoreKey), account, na.id)
+}
+
+// getPendingStore returns the execution pending for the account. The store must be
+// the authenticator store, writes made while authenticating are only persisted for
+// that store when the tx succeeds
+func (na NFTAuthenticator) getPendingStore(ctx sdk.Context, account sdk.AccAddress) prefix.Store {
+	return getAccountStore(ctx.KVStore(na.storeKey), account, na.id)
+}
+
+// getState decodes the value at the key into v and returns false if it isn't set
+func getState(store prefix.Store, key []byte, v interface{}) bool {
+	bz := store.Get(key)
+	if bz == nil {
+		return false
+	}
+	return json.Unmarshal(bz, v) == nil
+}
+
+// setState encodes v at the key
+func setState(store prefix.Store, key []byte, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// trackExecution returns the execution pending for the account. When it starts the
+// holders that authenticated each message are recorded, so ConfirmExecution checks
+// them for that message, and the balances needed to charge their spend limits are
+// recorded. False is returned when no holder authenticated a tx for the account
+func (na NFTAuthenticator) trackExecution(ctx sdk.Context, store prefix.Store, account sdk.AccAddress) (pendingExecution, bool) {
+	var pending pendingExecution
+	tx := hashTx(ctx)
+	if getState(store, pendingExecutionKey, &pending) {
+		if pending.Tx == tx {
+			return pending, true
+		}
+
+		// The execution of a tx that removed the authenticator is never confirmed, what
+		// was recorded for it is dropped
+		deleteState(ctx.KVStore(na.storeKey), account, na.id)
+		pending = pendingExecution{}
+	}
+	pending.Tx = tx
+
+	// Track is called once every message of the tx is authenticated and before any
+	// is executed, later calls, e.g. for the messages executed through authz, only
+	// count the messages
+	messages := na.authenticatedHolders(ctx, account)
+	if len(messages) == 0 {
+		return pending, false
+	}
+	for _, message := range messages {
+		setState(store, getPendingMessageKey(message.index), message.holders)
+		for _, holder := range message.holders {
+			if !containsString(pending.Holders, holder) {
+				pending.Holders = append(pending.Holders, holder)
+			}
+		}
+	}
+
+	// The balances are recorded once so the spend of the whole tx is charged
+	if na.config.SpendLimit != nil {
+		limit, _ := na.config.SpendLimit.Coins()
+		for _, coin := range limit {
+			pending.Balances = append(pending.Balances, na.bankKeeper.GetBalance(ctx, account, coin.Denom))
+		}
+	}
+	return pending, true
+}
+
+// hashTx returns the hash of the tx being executed, it is the same while the tx is
+// authenticated and while its messages execute
+func hashTx(ctx sdk.Context) string {
+	hash := sha256.Sum256(ctx.TxBytes())
+	return hex.EncodeToString(hash[:])
+}
+
+// authenticatedMessage is a message of the tx with the holders authenticated for it
+type authenticatedMessage struct {
+	index   int
+	holders []string
+}
+
+// authenticatedHolders returns the holders this authenticator authenticated for the
+// account in the tx by message. Authenticate only emits a nft_authenticated event for
+// them, so the state is written by Track and not charged to the gas the fee payer is
+// authenticated with. The events aren't trusted, ConfirmExecution checks every holder
+// signed the message they are recorded for
+func (na NFTAuthenticator) authenticatedHolders(ctx sdk.Context, account sdk.AccAddress) []authenticatedMessage {
+	var messages []authenticatedMessage
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != EventTypeAuthenticated {
+			continue
+		}
+
+		var signer string
+		var forAccount, forAuthenticator bool
+		index := -1
+		for _, attribute := range event.Attributes {
+			switch string(attribute.Key) {
+			case AttributeKeyAccount:
+				forAccount = string(attribute.Value) == account.String()
+			case AttributeKeyConfigHash:
+				forAuthenticator = string(attribute.Value) == na.id
+			case AttributeKeySigner:
+				signer = string(attribute.Value)
+			case AttributeKeyMsgIndex:
+				if i, err := strconv.Atoi(string(attribute.Value)); err == nil {
+					index = i
+				}
+			}
+		}
+		if !forAccount || !forAuthenticator || index < 0 {
+			continue
+		}
+
+		position := len(messages)
+		for i, message := range messages {
+			if message.index == index {
+				position = i
+				break
+			}
+		}
+		if position == len(messages) {
+			messages = append(messages, authenticatedMessage{index: index})
+		}
+		if !containsString(messages[position].holders, signer) {
+			messages[position].holders = append(messages[position].holders, signer)
+		}
+	}
+	return messages
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v19/x/authenticator/utils"
+)
+
+func getUsesKey(holder string) []byte {
+	return utils.BuildKey("uses", holder)
+}
+
+// getUses returns the number of messages the holder executed for the account
+func getUses(store prefix.Store, holder string) uint64 {
+	var uses uint64
+	getState(store, getUsesKey(holder), &uses)
+	return uses
+}
+
+// checkQuota fails when the messages tracked for the tx would take a holder past
+// the max uses of the config, a holder that used them up fails every tx until the
+// principal resets their uses
+func (na NFTAuthenticator) checkQuota(store prefix.Store, pending pendingExecution) error {
+	if na.config.MaxUses == 0 {
+		return nil
+	}
+	for _, holder := range pending.Holders {
+		if uses := getUses(store, holder); uses+pending.Messages > na.config.MaxUses {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s: holder %s used %d of %d uses and the tx executes %d messages", ReasonQuotaExhausted, holder, uses, na.config.MaxUses, pending.Messages)
+		}
+	}
+	return nil
+}
+
+// chargeUses adds the messages executed in the tx to the uses of every holder
+func (na NFTAuthenticator) chargeUses(store prefix.Store, pending pendingExecution) {
+	if na.config.MaxUses == 0 {
+		return
+	}
+	for _, holder := range pending.Holders {
+		setState(store, getUsesKey(holder), getUses(store, holder)+pending.Messages)
+	}
+}